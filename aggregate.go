@@ -0,0 +1,217 @@
+package collection
+
+import (
+	"cmp"
+	"context"
+	"iter"
+)
+
+// Number is satisfied by each of Go's built-in integer and floating point types, and any types derived from them.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Aggregate applies an accumulator function to each value of an Enumerable in turn, beginning with `seed`, and returns
+// the final accumulated value. If the Enumerable fails, its error is returned instead of a partial accumulation.
+func Aggregate[T any, A any](subject Enumerable[T], seed A, accumulate func(A, T) A) (A, error) {
+	original, originalErr := asSequence(context.Background(), subject)
+
+	retval := seed
+	for entry := range original {
+		retval = accumulate(retval, entry)
+	}
+
+	if err := originalErr(); err != nil {
+		return *new(A), err
+	}
+	return retval, nil
+}
+
+// AggregateSelect applies an accumulator function to each value of an Enumerable in turn, beginning with `seed`, then
+// transforms the final accumulated value into a result. If the Enumerable fails, result is not called, and the error is
+// returned.
+func AggregateSelect[T any, A any, R any](subject Enumerable[T], seed A, accumulate func(A, T) A, result Transform[A, R]) (R, error) {
+	accumulated, err := Aggregate(subject, seed, accumulate)
+	if err != nil {
+		return *new(R), err
+	}
+	return result(accumulated), nil
+}
+
+// Average finds the arithmetic mean of the values of an Enumerable. If there are no values, an error is returned which
+// can be identified with IsErrorNoElements.
+func Average[N Number](subject Enumerable[N]) (float64, error) {
+	original, originalErr := asSequence(context.Background(), subject)
+
+	var sum float64
+	var count uint
+	for entry := range original {
+		sum += float64(entry)
+		count++
+	}
+
+	if err := originalErr(); err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, errNoElements
+	}
+	return sum / float64(count), nil
+}
+
+// Fold applies an accumulator function to each value of an Enumerable in turn, using the first value as the seed, and
+// returns the final accumulated value. If there are no values, an error is returned which can be identified with
+// IsErrorNoElements.
+func Fold[T any](subject Enumerable[T], accumulate func(T, T) T) (retval T, err error) {
+	original, originalErr := asSequence(context.Background(), subject)
+
+	err = errNoElements
+	for entry := range original {
+		if err != nil {
+			retval, err = entry, nil
+			continue
+		}
+		retval = accumulate(retval, entry)
+	}
+
+	if sourceErr := originalErr(); sourceErr != nil {
+		retval, err = *new(T), sourceErr
+	}
+	return
+}
+
+type scanner[T any, A any] struct {
+	original   Enumerable[T]
+	seed       A
+	accumulate func(A, T) A
+}
+
+// Scan creates a reusable stream of running accumulations. Each value of an Enumerable is combined with the
+// accumulation so far, beginning with `seed`, and the result is produced before moving on to the next value. The seed
+// itself is not produced.
+//
+// Unlike Aggregate, Scan is lazy, so it may be used on Enumerables which never end.
+func Scan[T any, A any](subject Enumerable[T], seed A, accumulate func(A, T) A) Enumerable[A] {
+	return scanner[T, A]{
+		original:   subject,
+		seed:       seed,
+		accumulate: accumulate,
+	}
+}
+
+func (s scanner[T, A]) Enumerate(ctx context.Context) Enumerator[A] {
+	return enumerateSequence[A](ctx, s)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (s scanner[T, A]) EnumerateE(ctx context.Context) (Enumerator[A], func() error) {
+	return enumerateSequenceE[A](ctx, s)
+}
+
+func (s scanner[T, A]) sequence(ctx context.Context) (iter.Seq[A], func() error) {
+	original, err := asSequence(ctx, s.original)
+	return func(yield func(A) bool) {
+		current := s.seed
+		for entry := range original {
+			current = s.accumulate(current, entry)
+			if !yield(current) {
+				return
+			}
+		}
+	}, err
+}
+
+// Max finds the greatest value of an Enumerable. If there are no values, an error is returned which can be identified
+// with IsErrorNoElements.
+func Max[N cmp.Ordered](subject Enumerable[N]) (N, error) {
+	return MaxFunc(subject, CompareOrdered[N])
+}
+
+// MaxBy finds the value of an Enumerable with the greatest key. When several values share the greatest key, the first
+// of them is returned. If there are no values, an error is returned which can be identified with IsErrorNoElements.
+func MaxBy[T any, K cmp.Ordered](subject Enumerable[T], key Transform[T, K]) (T, error) {
+	return extremeBy(subject, key, func(candidate, current K) bool {
+		return cmp.Compare(candidate, current) > 0
+	})
+}
+
+// MaxFunc finds the greatest value of an Enumerable, as determined by a Comparator. When several values are equally
+// great, the first of them is returned. If there are no values, an error is returned which can be identified with
+// IsErrorNoElements. If comparator returns an error, enumeration stops and that error is returned.
+func MaxFunc[T any](subject Enumerable[T], comparator Comparator[T]) (T, error) {
+	return extremeFunc(subject, func(candidate, current T) (bool, error) {
+		res, err := comparator(candidate, current)
+		return res > 0, err
+	})
+}
+
+// Min finds the least value of an Enumerable. If there are no values, an error is returned which can be identified
+// with IsErrorNoElements.
+func Min[N cmp.Ordered](subject Enumerable[N]) (N, error) {
+	return MinFunc(subject, CompareOrdered[N])
+}
+
+// MinBy finds the value of an Enumerable with the least key. When several values share the least key, the first of
+// them is returned. If there are no values, an error is returned which can be identified with IsErrorNoElements.
+func MinBy[T any, K cmp.Ordered](subject Enumerable[T], key Transform[T, K]) (T, error) {
+	return extremeBy(subject, key, func(candidate, current K) bool {
+		return cmp.Compare(candidate, current) < 0
+	})
+}
+
+// MinFunc finds the least value of an Enumerable, as determined by a Comparator. When several values are equally
+// small, the first of them is returned. If there are no values, an error is returned which can be identified with
+// IsErrorNoElements. If comparator returns an error, enumeration stops and that error is returned.
+func MinFunc[T any](subject Enumerable[T], comparator Comparator[T]) (T, error) {
+	return extremeFunc(subject, func(candidate, current T) (bool, error) {
+		res, err := comparator(candidate, current)
+		return res < 0, err
+	})
+}
+
+// Sum adds together each value of an Enumerable. If there are no values, an error is returned which can be identified
+// with IsErrorNoElements.
+func Sum[N Number](subject Enumerable[N]) (N, error) {
+	return Fold(subject, func(sum, entry N) N {
+		return sum + entry
+	})
+}
+
+// extremeBy finds the first value whose key is preferred over the keys of all others. Each key is only selected once.
+func extremeBy[T any, K any](subject Enumerable[T], key Transform[T, K], preferred func(candidate, current K) bool) (T, error) {
+	keyed := Select(subject, func(entry T) Pair[T, K] {
+		return Pair[T, K]{First: entry, Second: key(entry)}
+	})
+
+	retval, err := extremeFunc(keyed, func(candidate, current Pair[T, K]) (bool, error) {
+		return preferred(candidate.Second, current.Second), nil
+	})
+	return retval.First, err
+}
+
+// extremeFunc finds the first value which is preferred over all others.
+func extremeFunc[T any](subject Enumerable[T], preferred func(candidate, current T) (bool, error)) (retval T, err error) {
+	original, originalErr := asSequence(context.Background(), subject)
+
+	err = errNoElements
+	for entry := range original {
+		if err != nil {
+			retval, err = entry, nil
+			continue
+		}
+
+		var replace bool
+		if replace, err = preferred(entry, retval); err != nil {
+			return *new(T), err
+		} else if replace {
+			retval = entry
+		}
+	}
+
+	if sourceErr := originalErr(); sourceErr != nil {
+		retval, err = *new(T), sourceErr
+	}
+	return
+}
@@ -0,0 +1,101 @@
+package collection_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleAggregate() {
+	subject := collection.NewLinkedList("alfa", "bravo", "charlie")
+	fmt.Println(collection.Aggregate[string](subject, 0, func(sum int, word string) int {
+		return sum + len(word)
+	}))
+	// Output: 16 <nil>
+}
+
+func ExampleAggregateSelect() {
+	subject := collection.AsEnumerable("alfa", "bravo", "charlie")
+	fmt.Println(collection.AggregateSelect(subject, &strings.Builder{}, func(acc *strings.Builder, word string) *strings.Builder {
+		acc.WriteByte(word[0])
+		return acc
+	}, (*strings.Builder).String))
+	// Output: abc <nil>
+}
+
+func ExampleFold() {
+	subject := collection.AsEnumerable(3, 1, 4, 1, 5)
+	fmt.Println(collection.Fold(subject, func(a, b int) int {
+		return a * b
+	}))
+	fmt.Println(collection.Fold(collection.Empty[int](), func(a, b int) int {
+		return a * b
+	}))
+	// Output:
+	// 60 <nil>
+	// 0 enumerator encountered no elements
+}
+
+func ExampleScan() {
+	runningTotals := collection.Scan(collection.Fibonacci, uint(0), func(sum, x uint) uint {
+		return sum + x
+	})
+	fmt.Println(collection.ToSlice(collection.Take(runningTotals, 8)))
+	// Output: [0 1 2 4 7 12 20 33]
+}
+
+func ExampleSum() {
+	fmt.Println(collection.Sum(collection.Take(collection.Fibonacci, 10)))
+	fmt.Println(collection.Sum(collection.AsEnumerable(1.5, 2.25)))
+	fmt.Println(collection.Sum(collection.Empty[int]()))
+	// Output:
+	// 88 <nil>
+	// 3.75 <nil>
+	// 0 enumerator encountered no elements
+}
+
+func ExampleAverage() {
+	fmt.Println(collection.Average(collection.AsEnumerable(1, 2, 3, 4)))
+	fmt.Println(collection.Average(collection.Empty[int]()))
+	// Output:
+	// 2.5 <nil>
+	// 0 enumerator encountered no elements
+}
+
+func ExampleMin() {
+	fmt.Println(collection.Min(collection.AsEnumerable(3, 1, 4, 1, 5)))
+	fmt.Println(collection.Min(collection.Empty[int]()))
+	// Output:
+	// 1 <nil>
+	// 0 enumerator encountered no elements
+}
+
+func ExampleMax() {
+	fmt.Println(collection.Max[string](collection.NewList("pear", "apple", "plum")))
+	// Output: plum <nil>
+}
+
+func ExampleMinBy() {
+	subject := collection.AsEnumerable("pear", "fig", "apple", "kiwi", "yam")
+	fmt.Println(collection.MinBy(subject, func(fruit string) int {
+		return len(fruit)
+	}))
+	// Output: fig <nil>
+}
+
+func ExampleMaxBy() {
+	subject := collection.AsEnumerable("pear", "fig", "apple", "kiwi", "melon")
+	fmt.Println(collection.MaxBy(subject, func(fruit string) int {
+		return len(fruit)
+	}))
+	// Output: apple <nil>
+}
+
+func ExampleMaxFunc() {
+	subject := collection.AsEnumerable("Banana", "apple", "Cherry")
+	fmt.Println(collection.MaxFunc(subject, func(a, b string) (int, error) {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b)), nil
+	}))
+	// Output: Cherry <nil>
+}
@@ -0,0 +1,111 @@
+package collection
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFold_Error(t *testing.T) {
+	errBroken := errors.New("broken")
+	subject := FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) && yield(2, nil) {
+			yield(0, errBroken)
+		}
+	})
+
+	got, err := Fold(subject, func(a, b int) int {
+		return a + b
+	})
+	if !errors.Is(err, errBroken) {
+		t.Logf("got: %v %v\nwant: %v", got, err, errBroken)
+		t.Fail()
+	}
+}
+
+func TestAggregate_Error(t *testing.T) {
+	errBroken := errors.New("broken")
+	subject := FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) && yield(2, nil) {
+			yield(0, errBroken)
+		}
+	})
+
+	got, err := Aggregate(subject, 10, func(sum, x int) int {
+		return sum + x
+	})
+	if !errors.Is(err, errBroken) || got != 0 {
+		t.Logf("got: %v %v\nwant: %v %v", got, err, 0, errBroken)
+		t.Fail()
+	}
+
+	called := false
+	_, err = AggregateSelect(subject, 10, func(sum, x int) int {
+		return sum + x
+	}, func(sum int) string {
+		called = true
+		return "unreachable"
+	})
+	if !errors.Is(err, errBroken) || called {
+		t.Logf("got: %v (result called: %v)\nwant: %v", err, called, errBroken)
+		t.Fail()
+	}
+}
+
+func TestSum_Error(t *testing.T) {
+	if got, err := Sum(Empty[int]()); !IsErrorNoElements(err) {
+		t.Logf("got: %v %v\nwant: %v", got, err, errNoElements)
+		t.Fail()
+	}
+
+	errBroken := errors.New("broken")
+	subject := FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) {
+			yield(0, errBroken)
+		}
+	})
+	if got, err := Sum(subject); !errors.Is(err, errBroken) || got != 0 {
+		t.Logf("got: %v %v\nwant: %v %v", got, err, 0, errBroken)
+		t.Fail()
+	}
+}
+
+func TestFold_Single(t *testing.T) {
+	got, err := Fold(AsEnumerable(7), func(a, b int) int {
+		t.Log("accumulate should not be called for a single element")
+		t.Fail()
+		return a
+	})
+	if err != nil || got != 7 {
+		t.Logf("got: %v %v\nwant: %v %v", got, err, 7, nil)
+		t.Fail()
+	}
+}
+
+func TestMinFunc_ComparatorError(t *testing.T) {
+	errIncomparable := errors.New("incomparable")
+	got, err := MinFunc(AsEnumerable(1, 2, 3), func(a, b int) (int, error) {
+		if a == 3 {
+			return 0, errIncomparable
+		}
+		return UncheckedComparatori(a, b)
+	})
+
+	if !errors.Is(err, errIncomparable) || got != 0 {
+		t.Logf("got: %v %v\nwant: %v %v", got, err, 0, errIncomparable)
+		t.Fail()
+	}
+}
+
+func TestMax_SourceError(t *testing.T) {
+	errBroken := errors.New("broken")
+	subject := FromSeqE(func(yield func(int, error) bool) {
+		if yield(4, nil) {
+			yield(0, errBroken)
+		}
+	})
+
+	if got, err := Max[int](subject); !errors.Is(err, errBroken) {
+		t.Logf("got: %v %v\nwant: %v", got, err, errBroken)
+		t.Fail()
+	}
+}
@@ -0,0 +1,200 @@
+package collection
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+type chunker[T any] struct {
+	original Enumerable[T]
+	size     uint
+	step     uint
+	partial  bool
+}
+
+// Chunk creates a reusable stream which gathers the values of an Enumerable into batches of `size` values. The last
+// batch holds whatever values remain, so it may be smaller. Each batch is a new slice, which may be retained by the
+// caller. If size is zero, no batches are produced.
+func Chunk[T any](subject Enumerable[T], size uint) Enumerable[[]T] {
+	return chunker[T]{
+		original: subject,
+		size:     size,
+		step:     size,
+		partial:  true,
+	}
+}
+
+// SlidingWindow creates a reusable stream of overlapping windows over the values of an Enumerable. Each window holds
+// `size` consecutive values, and begins `step` values after the previous one. When step is larger than size, the
+// values between windows are skipped. Only complete windows are produced. Each window is a new slice, which may be
+// retained by the caller. If either size or step is zero, no windows are produced.
+func SlidingWindow[T any](subject Enumerable[T], size, step uint) Enumerable[[]T] {
+	return chunker[T]{
+		original: subject,
+		size:     size,
+		step:     step,
+	}
+}
+
+func (c chunker[T]) Enumerate(ctx context.Context) Enumerator[[]T] {
+	return enumerateSequence[[]T](ctx, c)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (c chunker[T]) EnumerateE(ctx context.Context) (Enumerator[[]T], func() error) {
+	return enumerateSequenceE[[]T](ctx, c)
+}
+
+func (c chunker[T]) sequence(ctx context.Context) (iter.Seq[[]T], func() error) {
+	original, err := asSequence(ctx, c.original)
+	return func(yield func([]T) bool) {
+		if c.size == 0 || c.step == 0 {
+			return
+		}
+
+		window := make([]T, 0, c.size)
+		skip := uint(0)
+		for entry := range original {
+			if skip > 0 {
+				skip--
+				continue
+			}
+
+			window = append(window, entry)
+			if uint(len(window)) < c.size {
+				continue
+			}
+
+			if !yield(append([]T(nil), window...)) {
+				return
+			}
+
+			if c.step >= c.size {
+				window = window[:0]
+				skip = c.step - c.size
+			} else {
+				window = append(window[:0], window[c.step:]...)
+			}
+		}
+
+		if c.partial && len(window) > 0 {
+			yield(window)
+		}
+	}, err
+}
+
+type durationWindower[T any] struct {
+	original Enumerable[T]
+	interval time.Duration
+}
+
+// WindowByDuration creates a reusable stream which gathers the values of an Enumerable into batches, producing a batch
+// each time `interval` elapses. Empty batches are not produced. Once the Enumerable ends, or the context used to
+// enumerate the batches is cancelled, any values that have been gathered are produced as a final partial batch. Each
+// batch is a new slice, which may be retained by the caller. If interval is not positive, no batches are produced.
+//
+// Enumerate and EnumerateE always deliver the final partial batch before closing, even once the context has been
+// cancelled. Queries built on top of WindowByDuration stop as soon as the context is cancelled, like any other, so they
+// may not see it. While the consumer has yet to read a batch, no more values are read from the Enumerable; they are
+// gathered into a larger batch once the consumer catches up.
+func WindowByDuration[T any](subject Enumerable[T], interval time.Duration) Enumerable[[]T] {
+	return durationWindower[T]{
+		original: subject,
+		interval: interval,
+	}
+}
+
+func (w durationWindower[T]) Enumerate(ctx context.Context) Enumerator[[]T] {
+	results, _ := w.EnumerateE(ctx)
+	return results
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (w durationWindower[T]) EnumerateE(ctx context.Context) (Enumerator[[]T], func() error) {
+	// Room is kept for one more batch than the consumer may have yet to read, so that the final partial batch can
+	// always be delivered without waiting on the consumer, who may have stopped reading once ctx was cancelled.
+	results := make(chan []T, 2)
+	var err error
+
+	go func() {
+		defer close(results)
+
+		if w.interval <= 0 {
+			return
+		}
+
+		err = w.gather(ctx, func() bool {
+			return len(results) == 0
+		}, func(batch []T) bool {
+			results <- batch
+			return true
+		})
+	}()
+
+	return results, func() error { return err }
+}
+
+func (w durationWindower[T]) sequence(ctx context.Context) (iter.Seq[[]T], func() error) {
+	var err error
+	return func(yield func([]T) bool) {
+		if w.interval <= 0 {
+			return
+		}
+
+		err = w.gather(ctx, func() bool {
+			return true
+		}, yield)
+	}, func() error { return err }
+}
+
+// gather reads the original Enumerable, and hands each batch to `emit` as it becomes due. A batch which is due is held
+// back until `ready` reports that the consumer is able to take it, and no more values are read in the meantime. The
+// final partial batch is emitted regardless of ready. It returns the error, if any, which ended the enumeration early.
+func (w durationWindower[T]) gather(ctx context.Context, ready func() bool, emit func([]T) bool) error {
+	child, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results, resultsErr := EnumerateE(child, w.original)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	var window []T
+	flush := func() bool {
+		if len(window) == 0 {
+			return true
+		}
+		current := window
+		window = nil
+		return emit(current)
+	}
+
+	// incoming is nil while a batch is being held back, so that no more values are read until it has been emitted.
+	incoming := results
+	for {
+		select {
+		case entry, ok := <-incoming:
+			if !ok {
+				flush()
+				return resultsErr()
+			}
+			window = append(window, entry)
+		case <-ticker.C:
+			if len(window) == 0 {
+				continue
+			}
+			if !ready() {
+				incoming = nil
+				continue
+			}
+			incoming = results
+			if !flush() {
+				return nil
+			}
+		case <-ctx.Done():
+			flush()
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package collection_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleChunk() {
+	pending := collection.NewQueue(1, 2, 3, 5, 8, 13, 21)
+	for batch := range collection.Chunk[int](pending, 3).Enumerate(context.Background()) {
+		fmt.Println(batch)
+	}
+	// Output:
+	// [1 2 3]
+	// [5 8 13]
+	// [21]
+}
+
+func ExampleSlidingWindow() {
+	subject := collection.AsEnumerable(1, 2, 3, 4, 5, 6)
+	fmt.Println(collection.ToSlice(collection.SlidingWindow(subject, 3, 1)))
+	fmt.Println(collection.ToSlice(collection.SlidingWindow(subject, 2, 3)))
+	// Output:
+	// [[1 2 3] [2 3 4] [3 4 5] [4 5 6]]
+	// [[1 2] [4 5]]
+}
+
+func ExampleWindowByDuration() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subject := collection.FromSeq(func(yield func(int) bool) {
+		for i := 1; i <= 5; i++ {
+			if !yield(i) {
+				return
+			}
+			if i == 3 {
+				time.Sleep(300 * time.Millisecond)
+			}
+		}
+	})
+
+	for batch := range collection.WindowByDuration(subject, 200*time.Millisecond).Enumerate(ctx) {
+		fmt.Println(batch)
+	}
+	// Output:
+	// [1 2 3]
+	// [4 5]
+}
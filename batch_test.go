@@ -0,0 +1,93 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChunk_ZeroSize(t *testing.T) {
+	if Any(Chunk(AsEnumerable(1, 2, 3), 0)) {
+		t.Log("no chunks should be produced for a size of zero")
+		t.Fail()
+	}
+}
+
+func TestWindowByDuration_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subject := FromSeq(func(yield func(int) bool) {
+		if !yield(1) {
+			return
+		}
+		cancel()
+		<-time.After(time.Second)
+	})
+
+	results, err := EnumerateE(ctx, WindowByDuration(subject, time.Hour))
+
+	batches := 0
+	for batch := range results {
+		batches++
+		if len(batch) != 1 || batch[0] != 1 {
+			t.Logf("got: %v\nwant: %v", batch, []int{1})
+			t.Fail()
+		}
+	}
+
+	if batches != 1 {
+		t.Logf("the partial batch should have been flushed, got %d batches", batches)
+		t.Fail()
+	}
+
+	if !errors.Is(err(), context.Canceled) {
+		t.Logf("got: %v\nwant: %v", err(), context.Canceled)
+		t.Fail()
+	}
+}
+
+func TestWindowByDuration_CancelledWhileBehind(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The first batch is left unread while the second is gathered, then enumeration is cancelled before the consumer
+	// has read either.
+	subject := FromSeq(func(yield func(int) bool) {
+		if !yield(1) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+		if !yield(2) {
+			return
+		}
+		cancel()
+		<-time.After(time.Second)
+	})
+	results := WindowByDuration(subject, time.Millisecond).Enumerate(ctx)
+	<-ctx.Done()
+
+	var got []int
+	for batch := range results {
+		got = append(got, batch...)
+	}
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Logf("got: %v\nwant: %v", got, []int{1, 2})
+		t.Fail()
+	}
+}
+
+func TestWindowByDuration_NonPositiveInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		results, err := EnumerateE(context.Background(), WindowByDuration(AsEnumerable(1, 2, 3), interval))
+		if got := results.ToSlice(); len(got) != 0 {
+			t.Logf("interval %v\ngot: %v\nwant: no batches", interval, got)
+			t.Fail()
+		}
+		if err() != nil {
+			t.Logf("interval %v\ngot: %v\nwant: %v", interval, err(), nil)
+			t.Fail()
+		}
+	}
+}
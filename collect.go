@@ -0,0 +1,141 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// A collection of errors that may be thrown by functions in this file.
+var (
+	ErrDuplicateKey = errors.New("key was encountered more than once")
+)
+
+// DuplicateKeyPolicy decides which value ToMap keeps when more than one value is found for the same key. It is given
+// the value already held for the key, and the value which was just encountered. If it returns an error, ToMap stops and
+// returns that error.
+//
+// KeepFirst, KeepLast and RejectDuplicates may be used as DuplicateKeyPolicies, or a function which merges the two
+// values may be provided.
+type DuplicateKeyPolicy[K comparable, V any] func(key K, existing, incoming V) (V, error)
+
+// KeepFirst is a DuplicateKeyPolicy which ignores any value found after the first for a key.
+func KeepFirst[K comparable, V any](_ K, existing, _ V) (V, error) {
+	return existing, nil
+}
+
+// KeepLast is a DuplicateKeyPolicy which replaces the value held for a key each time a new one is found.
+func KeepLast[K comparable, V any](_ K, _, incoming V) (V, error) {
+	return incoming, nil
+}
+
+// RejectDuplicates is a DuplicateKeyPolicy which fails as soon as a key is found more than once. The error returned
+// can be identified with errors.Is and ErrDuplicateKey.
+func RejectDuplicates[K comparable, V any](key K, existing, _ V) (V, error) {
+	return existing, fmt.Errorf("%w: %v", ErrDuplicateKey, key)
+}
+
+// ToDictionary places each word produced by an Enumerable into a new Dictionary. If enumeration ends early because ctx
+// is cancelled or the Enumerable fails, the words seen so far are returned along with the error.
+func ToDictionary(ctx context.Context, subject Enumerable[string]) (*Dictionary, error) {
+	retval := &Dictionary{}
+	err := collect(ctx, subject, func(word string) error {
+		retval.Add(word)
+		return nil
+	})
+	return retval, err
+}
+
+// ToLinkedList places each value produced by an Enumerable into a new LinkedList, in the order they were produced. If
+// enumeration ends early because ctx is cancelled or the Enumerable fails, the values seen so far are returned along
+// with the error.
+func ToLinkedList[T any](ctx context.Context, subject Enumerable[T]) (*LinkedList[T], error) {
+	retval := NewLinkedList[T]()
+	err := collect(ctx, subject, func(entry T) error {
+		retval.AddBack(entry)
+		return nil
+	})
+	return retval, err
+}
+
+// ToList places each value produced by an Enumerable into a new List, in the order they were produced. If enumeration
+// ends early because ctx is cancelled or the Enumerable fails, the values seen so far are returned along with the
+// error.
+func ToList[T any](ctx context.Context, subject Enumerable[T]) (*List[T], error) {
+	retval := NewList[T]()
+	err := collect(ctx, subject, func(entry T) error {
+		retval.Add(entry)
+		return nil
+	})
+	return retval, err
+}
+
+// ToMap builds a map from the values produced by an Enumerable, using `key` and `value` to select each entry. When
+// more than one value has the same key, `onDuplicate` decides which is kept. If onDuplicate is nil, RejectDuplicates is
+// used.
+//
+// If enumeration ends early because ctx is cancelled, the Enumerable fails, or onDuplicate returns an error, the
+// entries seen so far are returned along with the error.
+func ToMap[T any, K comparable, V any](ctx context.Context, subject Enumerable[T], key Transform[T, K], value Transform[T, V], onDuplicate DuplicateKeyPolicy[K, V]) (map[K]V, error) {
+	if onDuplicate == nil {
+		onDuplicate = RejectDuplicates[K, V]
+	}
+
+	retval := make(map[K]V)
+	err := collect(ctx, subject, func(entry T) (err error) {
+		k, v := key(entry), value(entry)
+		if existing, ok := retval[k]; ok {
+			v, err = onDuplicate(k, existing, v)
+		}
+		retval[k] = v
+		return
+	})
+	return retval, err
+}
+
+// ToQueue places each value produced by an Enumerable into a new Queue, so that they will be removed from it in the
+// order they were produced. If enumeration ends early because ctx is cancelled or the Enumerable fails, the values seen
+// so far are returned along with the error.
+func ToQueue[T any](ctx context.Context, subject Enumerable[T]) (*Queue[T], error) {
+	retval := NewQueue[T]()
+	err := collect(ctx, subject, func(entry T) error {
+		retval.Add(entry)
+		return nil
+	})
+	return retval, err
+}
+
+// ToSet gathers the distinct values produced by an Enumerable. If enumeration ends early because ctx is cancelled or
+// the Enumerable fails, the values seen so far are returned along with the error.
+func ToSet[T comparable](ctx context.Context, subject Enumerable[T]) (map[T]struct{}, error) {
+	retval := make(map[T]struct{})
+	err := collect(ctx, subject, func(entry T) error {
+		retval[entry] = struct{}{}
+		return nil
+	})
+	return retval, err
+}
+
+// ToStack pushes each value produced by an Enumerable onto a new Stack, so that the last value produced is on top. If
+// enumeration ends early because ctx is cancelled or the Enumerable fails, the values seen so far are returned along
+// with the error.
+func ToStack[T any](ctx context.Context, subject Enumerable[T]) (*Stack[T], error) {
+	retval := NewStack[T]()
+	err := collect(ctx, subject, func(entry T) error {
+		retval.Push(entry)
+		return nil
+	})
+	return retval, err
+}
+
+// collect hands each value produced by an Enumerable to `add`, stopping at the first error encountered by either.
+func collect[T any](ctx context.Context, subject Enumerable[T], add func(T) error) error {
+	seq, seqErr := asSequence(ctx, subject)
+	for entry := range seq {
+		if err := add(entry); err != nil {
+			return err
+		}
+	}
+
+	return seqErr()
+}
@@ -0,0 +1,67 @@
+package collection_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleToMap() {
+	subject := collection.AsEnumerable("apple", "avocado", "banana")
+	byLetter, err := collection.ToMap(context.Background(), subject, func(s string) string {
+		return s[:1]
+	}, collection.Identity[string](), func(_ string, existing, incoming string) (string, error) {
+		return existing + " & " + incoming, nil
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(byLetter["a"])
+	fmt.Println(byLetter["b"])
+	// Output:
+	// apple & avocado
+	// banana
+}
+
+func ExampleToMap_rejectDuplicates() {
+	subject := collection.AsEnumerable("apple", "avocado")
+	_, err := collection.ToMap(context.Background(), subject, func(s string) string {
+		return s[:1]
+	}, collection.Identity[string](), collection.RejectDuplicates)
+
+	fmt.Println(err)
+	// Output: key was encountered more than once: a
+}
+
+func ExampleToDictionary() {
+	words := collection.Select(collection.AsEnumerable("Cherry", "apple", "BANANA"), strings.ToLower)
+	dict, err := collection.ToDictionary(context.Background(), words)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(collection.ToSlice[string](dict))
+	// Output: [apple banana cherry]
+}
+
+func ExampleToStack() {
+	stack, err := collection.ToStack(context.Background(), collection.Range(1, 3))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for !stack.IsEmpty() {
+		top, _ := stack.Pop()
+		fmt.Println(top)
+	}
+	// Output:
+	// 3
+	// 2
+	// 1
+}
@@ -0,0 +1,100 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestToMap_DuplicatePolicies(t *testing.T) {
+	subject := AsEnumerable("apple", "avocado", "banana", "blueberry", "cherry")
+	firstLetter := func(s string) byte { return s[0] }
+
+	testCases := []struct {
+		name   string
+		policy DuplicateKeyPolicy[byte, string]
+		want   map[byte]string
+	}{
+		{"KeepFirst", KeepFirst[byte, string], map[byte]string{'a': "apple", 'b': "banana", 'c': "cherry"}},
+		{"KeepLast", KeepLast[byte, string], map[byte]string{'a': "avocado", 'b': "blueberry", 'c': "cherry"}},
+		{"merge", func(_ byte, existing, incoming string) (string, error) {
+			return existing + "," + incoming, nil
+		}, map[byte]string{'a': "apple,avocado", 'b': "banana,blueberry", 'c': "cherry"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ToMap(context.Background(), subject, firstLetter, Identity[string](), tc.policy)
+			if err != nil {
+				t.Error(err)
+			}
+			if len(got) != len(tc.want) {
+				t.Logf("got: %v\nwant: %v", got, tc.want)
+				t.FailNow()
+			}
+			for k, v := range tc.want {
+				if got[k] != v {
+					t.Logf("got: %v\nwant: %v", got, tc.want)
+					t.FailNow()
+				}
+			}
+		})
+	}
+}
+
+func TestToMap_RejectDuplicates(t *testing.T) {
+	subject := AsEnumerable(1, 2, 3, 12)
+	lastDigit := func(x int) int { return x % 10 }
+
+	for _, policy := range []DuplicateKeyPolicy[int, int]{nil, RejectDuplicates[int, int]} {
+		_, err := ToMap(context.Background(), subject, lastDigit, Identity[int](), policy)
+		if !errors.Is(err, ErrDuplicateKey) {
+			t.Logf("got: %v\nwant: %v", err, ErrDuplicateKey)
+			t.Fail()
+		}
+	}
+}
+
+func TestToSet_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	got, err := ToSet[uint](ctx, Fibonacci)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Logf("got: %v\nwant: %v", err, context.DeadlineExceeded)
+		t.Fail()
+	}
+	if _, ok := got[5]; !ok {
+		t.Logf("values seen before cancellation should be returned, got: %d values", len(got))
+		t.Fail()
+	}
+}
+
+func TestToStack_Order(t *testing.T) {
+	got, err := ToStack(context.Background(), AsEnumerable(1, 2, 3))
+	if err != nil {
+		t.Error(err)
+	}
+
+	if top, ok := got.Peek(); !ok || top != 3 {
+		t.Logf("got: %v\nwant: %v", top, 3)
+		t.Fail()
+	}
+}
+
+func TestToDictionary(t *testing.T) {
+	got, err := ToDictionary(context.Background(), AsEnumerable("banana", "apple", "banana"))
+	if err != nil {
+		t.Error(err)
+	}
+
+	if got.Size() != 2 {
+		t.Logf("got: %d words\nwant: %d words", got.Size(), 2)
+		t.Fail()
+	}
+	if !got.Contains("apple") || !got.Contains("banana") {
+		t.Logf("got: %v\nwant: %v", ToSlice[string](got), []string{"apple", "banana"})
+		t.Fail()
+	}
+}
@@ -0,0 +1,239 @@
+package collection
+
+import (
+	"context"
+	"iter"
+)
+
+type crossJoiner[A any, B any] struct {
+	a Enumerable[A]
+	b Enumerable[B]
+}
+
+// CrossJoin creates a reusable stream which pairs every value of `a` with every value of `b`. All pairs for the first
+// value of a are produced before any for its second value, and so on.
+//
+// Values are paired as they are read, rather than being gathered up front, so b is enumerated once for each value of a.
+func CrossJoin[A any, B any](a Enumerable[A], b Enumerable[B]) Enumerable[Pair[A, B]] {
+	return crossJoiner[A, B]{
+		a: a,
+		b: b,
+	}
+}
+
+func (cj crossJoiner[A, B]) Enumerate(ctx context.Context) Enumerator[Pair[A, B]] {
+	return enumerateSequence[Pair[A, B]](ctx, cj)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration of either side early.
+func (cj crossJoiner[A, B]) EnumerateE(ctx context.Context) (Enumerator[Pair[A, B]], func() error) {
+	return enumerateSequenceE[Pair[A, B]](ctx, cj)
+}
+
+func (cj crossJoiner[A, B]) sequence(ctx context.Context) (iter.Seq[Pair[A, B]], func() error) {
+	var err error
+	return func(yield func(Pair[A, B]) bool) {
+		a, aErr := asSequence(ctx, cj.a)
+		defer func() {
+			if err == nil {
+				err = aErr()
+			}
+		}()
+
+		for first := range a {
+			b, bErr := asSequence(ctx, cj.b)
+			for second := range b {
+				if err = ctx.Err(); err != nil {
+					return
+				}
+				if !yield(Pair[A, B]{First: first, Second: second}) {
+					return
+				}
+			}
+			if err = bErr(); err != nil {
+				return
+			}
+		}
+	}, func() error { return err }
+}
+
+type producter[T any] struct {
+	sources []Enumerable[T]
+}
+
+// Product creates a reusable stream of every way to choose one value from each source, in the order the sources were
+// provided. Each value produced is a new slice. Choices are produced in lexicographic order, with the last source
+// changing fastest. When there are no sources, a single empty slice is produced.
+//
+// Choices are built as they are needed, so every source but the first is enumerated once for each combination of the
+// values before it.
+func Product[T any](sources ...Enumerable[T]) Enumerable[[]T] {
+	return producter[T]{
+		sources: sources,
+	}
+}
+
+func (p producter[T]) Enumerate(ctx context.Context) Enumerator[[]T] {
+	return enumerateSequence[[]T](ctx, p)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration of any source early.
+func (p producter[T]) EnumerateE(ctx context.Context) (Enumerator[[]T], func() error) {
+	return enumerateSequenceE[[]T](ctx, p)
+}
+
+func (p producter[T]) sequence(ctx context.Context) (iter.Seq[[]T], func() error) {
+	var err error
+	return func(yield func([]T) bool) {
+		current := make([]T, 0, len(p.sources))
+
+		// choose fills in each position of current in turn, and reports false once enumeration should stop.
+		var choose func(depth int) bool
+		choose = func(depth int) bool {
+			if depth == len(p.sources) {
+				if err = ctx.Err(); err != nil {
+					return false
+				}
+				return yield(append([]T(nil), current...))
+			}
+
+			source, sourceErr := asSequence(ctx, p.sources[depth])
+			for entry := range source {
+				current = append(current[:depth], entry)
+				if !choose(depth + 1) {
+					return false
+				}
+			}
+			if err = sourceErr(); err != nil {
+				return false
+			}
+			return true
+		}
+		choose(0)
+	}, func() error { return err }
+}
+
+// Combinations creates a reusable stream of every way to choose `k` of the given items, without regard to order. Each
+// value produced is a new slice, holding the chosen items in the same order they appear in `items`. Combinations are
+// produced in lexicographic order of their positions in items. If k is greater than the number of items, nothing is
+// produced.
+func Combinations[T any](items []T, k uint) Enumerable[[]T] {
+	return generator[[]T]{
+		start: func() func(context.Context) ([]T, bool) {
+			return combinations(items, k)
+		},
+	}
+}
+
+// Permutations creates a reusable stream of every ordering of the given items. Each value produced is a new slice.
+// Orderings are produced in lexicographic order of the positions of the items, beginning with the items in the order
+// they were provided. Items which are equal to one another are still treated as distinct.
+func Permutations[T any](items []T) Enumerable[[]T] {
+	return generator[[]T]{
+		start: func() func(context.Context) ([]T, bool) {
+			var positions []int
+			return func(context.Context) ([]T, bool) {
+				if positions == nil {
+					positions = make([]int, len(items))
+					for i := range positions {
+						positions[i] = i
+					}
+				} else if !nextPermutation(positions) {
+					return nil, false
+				}
+				return pick(items, positions), true
+			}
+		},
+	}
+}
+
+// PowerSet creates a reusable stream of every subset of the given items, beginning with the empty set. Each value
+// produced is a new slice. Subsets are ordered by size, then lexicographically by the positions of their items, as in
+// Combinations.
+func PowerSet[T any](items []T) Enumerable[[]T] {
+	return generator[[]T]{
+		start: func() func(context.Context) ([]T, bool) {
+			k := uint(0)
+			next := combinations(items, k)
+			return func(ctx context.Context) ([]T, bool) {
+				for {
+					if current, ok := next(ctx); ok {
+						return current, true
+					}
+					if k >= uint(len(items)) {
+						return nil, false
+					}
+					k++
+					next = combinations(items, k)
+				}
+			}
+		},
+	}
+}
+
+// combinations creates a function which produces each way to choose k of the given items in turn.
+func combinations[T any](items []T, k uint) func(context.Context) ([]T, bool) {
+	var positions []int
+	return func(context.Context) ([]T, bool) {
+		if k > uint(len(items)) {
+			return nil, false
+		}
+
+		if positions == nil {
+			positions = make([]int, k)
+			for i := range positions {
+				positions[i] = i
+			}
+			return pick(items, positions), true
+		}
+
+		// Find the right-most position which can still be advanced, advance it, then reset every position after it to
+		// follow on directly.
+		n := len(items)
+		i := len(positions) - 1
+		for i >= 0 && positions[i] == n-len(positions)+i {
+			i--
+		}
+		if i < 0 {
+			return nil, false
+		}
+
+		positions[i]++
+		for j := i + 1; j < len(positions); j++ {
+			positions[j] = positions[j-1] + 1
+		}
+		return pick(items, positions), true
+	}
+}
+
+// nextPermutation rearranges positions into the permutation which follows it in lexicographic order. It reports false,
+// without changing positions, if positions was already the last permutation.
+func nextPermutation(positions []int) bool {
+	i := len(positions) - 2
+	for i >= 0 && positions[i] >= positions[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+
+	j := len(positions) - 1
+	for positions[j] <= positions[i] {
+		j--
+	}
+	positions[i], positions[j] = positions[j], positions[i]
+
+	for left, right := i+1, len(positions)-1; left < right; left, right = left+1, right-1 {
+		positions[left], positions[right] = positions[right], positions[left]
+	}
+	return true
+}
+
+// pick creates a new slice holding the items at each of the given positions.
+func pick[T any](items []T, positions []int) []T {
+	retval := make([]T, len(positions))
+	for i, position := range positions {
+		retval[i] = items[position]
+	}
+	return retval
+}
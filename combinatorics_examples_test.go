@@ -0,0 +1,55 @@
+package collection_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleCombinations() {
+	for hand := range collection.Combinations([]string{"a", "b", "c"}, 2).Enumerate(context.Background()) {
+		fmt.Println(hand)
+	}
+	// Output:
+	// [a b]
+	// [a c]
+	// [b c]
+}
+
+func ExampleCrossJoin() {
+	sizes := collection.AsEnumerable("small", "large")
+	colors := collection.AsEnumerable("red", "blue")
+
+	for variant := range collection.CrossJoin(sizes, colors).Enumerate(context.Background()) {
+		fmt.Println(variant.First, variant.Second)
+	}
+	// Output:
+	// small red
+	// small blue
+	// large red
+	// large blue
+}
+
+func ExamplePermutations() {
+	fmt.Println(collection.ToSlice(collection.Permutations([]int{1, 2, 3})))
+	// Output: [[1 2 3] [1 3 2] [2 1 3] [2 3 1] [3 1 2] [3 2 1]]
+}
+
+func ExamplePowerSet() {
+	fmt.Println(collection.ToSlice(collection.PowerSet([]string{"x", "y"})))
+	// Output: [[] [x] [y] [x y]]
+}
+
+func ExampleProduct() {
+	os := collection.AsEnumerable("linux", "windows")
+	arch := collection.AsEnumerable("amd64", "arm64")
+	versions := collection.AsEnumerable("1.22", "1.23")
+
+	matrix := collection.Product(os, arch, versions)
+	fmt.Println(collection.CountAll(matrix))
+	fmt.Println(collection.ToSlice(collection.Take(matrix, 2)))
+	// Output:
+	// 8
+	// [[linux amd64 1.22] [linux amd64 1.23]]
+}
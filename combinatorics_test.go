@@ -0,0 +1,100 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCombinatorics(t *testing.T) {
+	testCases := []struct {
+		name    string
+		subject Enumerable[[]int]
+		want    string
+	}{
+		{"Product", Product(AsEnumerable(1, 2), AsEnumerable(3, 4, 5)), "[[1 3] [1 4] [1 5] [2 3] [2 4] [2 5]]"},
+		{"Product/none", Product[int](), "[[]]"},
+		{"Product/empty", Product(AsEnumerable(1, 2), AsEnumerable[int]()), "[]"},
+		{"Permutations", Permutations([]int{1, 2, 3}), "[[1 2 3] [1 3 2] [2 1 3] [2 3 1] [3 1 2] [3 2 1]]"},
+		{"Permutations/empty", Permutations([]int{}), "[[]]"},
+		{"Permutations/repeated", Permutations([]int{1, 1}), "[[1 1] [1 1]]"},
+		{"Combinations", Combinations([]int{1, 2, 3, 4}, 2), "[[1 2] [1 3] [1 4] [2 3] [2 4] [3 4]]"},
+		{"Combinations/zero", Combinations([]int{1, 2}, 0), "[[]]"},
+		{"Combinations/all", Combinations([]int{1, 2}, 2), "[[1 2]]"},
+		{"Combinations/too many", Combinations([]int{1, 2}, 3), "[]"},
+		{"PowerSet", PowerSet([]int{1, 2, 3}), "[[] [1] [2] [3] [1 2] [1 3] [2 3] [1 2 3]]"},
+		{"PowerSet/empty", PowerSet([]int{}), "[[]]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 2; i++ {
+				got, err := ToSliceE(tc.subject)
+				if err != nil {
+					t.Error(err)
+				}
+				if fmt.Sprint(got) != tc.want {
+					t.Logf("enumeration %d\ngot: %v\nwant: %s", i, got, tc.want)
+					t.Fail()
+				}
+			}
+		})
+	}
+}
+
+func TestCrossJoin(t *testing.T) {
+	got := ToSlice(CrossJoin(AsEnumerable("a", "b"), Range(1, 2)))
+	want := "[{a 1} {a 2} {b 1} {b 2}]"
+
+	if fmt.Sprint(got) != want {
+		t.Logf("got: %v\nwant: %s", got, want)
+		t.Fail()
+	}
+}
+
+func TestCrossJoin_Error(t *testing.T) {
+	errBroken := errors.New("broken")
+	broken := FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) {
+			yield(0, errBroken)
+		}
+	})
+
+	got, err := ToSliceE(CrossJoin(AsEnumerable("a", "b"), Enumerable[int](broken)))
+	if !errors.Is(err, errBroken) {
+		t.Logf("got: %v\nwant: %v", err, errBroken)
+		t.Fail()
+	}
+	if len(got) != 1 {
+		t.Logf("got: %v\nwant: 1 value", got)
+		t.Fail()
+	}
+}
+
+func TestPermutations_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// 20! permutations could never be exhausted, so this only ends if cancellation is honored.
+	results, err := EnumerateE(ctx, Permutations(make([]int, 20)))
+	<-results
+	cancel()
+	for range results {
+		// Intentionally Left Blank
+	}
+
+	if !errors.Is(err(), context.Canceled) {
+		t.Logf("got: %v\nwant: %v", err(), context.Canceled)
+		t.Fail()
+	}
+}
+
+func TestProduct_Lazy(t *testing.T) {
+	got := ToSlice(Take(Product[uint](Fibonacci, AsEnumerable[uint](7, 8)), 3))
+	want := "[[0 7] [0 8] [1 7]]"
+
+	if fmt.Sprint(got) != want {
+		t.Logf("got: %v\nwant: %s", got, want)
+		t.Fail()
+	}
+}
@@ -0,0 +1,99 @@
+package collection
+
+import (
+	"context"
+	"iter"
+)
+
+type concatenator[T any] struct {
+	sources    []Enumerable[T]
+	interleave bool
+}
+
+// Append creates a reusable stream which produces each value of an Enumerable, followed by `value`.
+func Append[T any](subject Enumerable[T], value T) Enumerable[T] {
+	return Concat(subject, AsEnumerable(value))
+}
+
+// Concat creates a reusable stream which produces all of the values of each source in turn. A source isn't enumerated
+// until all of the sources before it have ended.
+func Concat[T any](sources ...Enumerable[T]) Enumerable[T] {
+	return concatenator[T]{
+		sources: sources,
+	}
+}
+
+// Interleave creates a reusable stream which takes one value from each source in turn, in the order the sources were
+// provided. Sources which have ended are skipped, and the stream continues until all of them have ended.
+func Interleave[T any](sources ...Enumerable[T]) Enumerable[T] {
+	return concatenator[T]{
+		sources:    sources,
+		interleave: true,
+	}
+}
+
+// Prepend creates a reusable stream which produces `value`, followed by each value of an Enumerable.
+func Prepend[T any](subject Enumerable[T], value T) Enumerable[T] {
+	return Concat(AsEnumerable(value), subject)
+}
+
+func (c concatenator[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, c)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration of any source early.
+func (c concatenator[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, c)
+}
+
+func (c concatenator[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	sources := make([]iter.Seq[T], len(c.sources))
+	sourceErrs := make([]func() error, len(c.sources))
+	for i, source := range c.sources {
+		sources[i], sourceErrs[i] = asSequence(ctx, source)
+	}
+	errs := firstError(sourceErrs...)
+
+	if !c.interleave {
+		return func(yield func(T) bool) {
+			for i, source := range sources {
+				for entry := range source {
+					if !yield(entry) {
+						return
+					}
+				}
+				if sourceErrs[i]() != nil {
+					return
+				}
+			}
+		}, errs
+	}
+
+	return func(yield func(T) bool) {
+		nexts := make([]func() (T, bool), 0, len(sources))
+		for _, source := range sources {
+			next, stop := iter.Pull(source)
+			defer stop()
+			nexts = append(nexts, next)
+		}
+
+		for len(nexts) > 0 {
+			remaining := nexts[:0]
+			for _, next := range nexts {
+				current, ok := next()
+				if errs() != nil {
+					return
+				}
+				if !ok {
+					continue
+				}
+				remaining = append(remaining, next)
+
+				if !yield(current) {
+					return
+				}
+			}
+			nexts = remaining
+		}
+	}, errs
+}
@@ -0,0 +1,32 @@
+package collection_test
+
+import (
+	"fmt"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleAppend() {
+	subject := collection.Append(collection.AsEnumerable("a", "b"), "c")
+	fmt.Println(collection.ToSlice(subject))
+	// Output: [a b c]
+}
+
+func ExampleConcat() {
+	subject := collection.Concat(collection.Range(1, 3), collection.Range(10, 2))
+	fmt.Println(collection.ToSlice(subject))
+	// Output: [1 2 3 10 11]
+}
+
+func ExampleInterleave() {
+	letters := collection.AsEnumerable("a", "b", "c")
+	numbers := collection.AsEnumerable("1", "2")
+	fmt.Println(collection.ToSlice(collection.Interleave(letters, numbers)))
+	// Output: [a 1 b 2 c]
+}
+
+func ExamplePrepend() {
+	subject := collection.Prepend(collection.AsEnumerable("b", "c"), "a")
+	fmt.Println(collection.ToSlice(subject))
+	// Output: [a b c]
+}
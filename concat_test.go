@@ -0,0 +1,92 @@
+package collection
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConcat(t *testing.T) {
+	testCases := []struct {
+		sources []Enumerable[int]
+		want    []int
+	}{
+		{nil, []int{}},
+		{[]Enumerable[int]{AsEnumerable[int]()}, []int{}},
+		{[]Enumerable[int]{AsEnumerable(1, 2), AsEnumerable[int](), AsEnumerable(3)}, []int{1, 2, 3}},
+	}
+
+	for _, tc := range testCases {
+		got := ToSlice(Concat(tc.sources...))
+		if len(got) != len(tc.want) {
+			t.Logf("got: %v\nwant: %v", got, tc.want)
+			t.Fail()
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Logf("got: %v\nwant: %v", got, tc.want)
+				t.Fail()
+				break
+			}
+		}
+	}
+}
+
+func TestConcat_Infinite(t *testing.T) {
+	got := ToSlice(Take(Concat(AsEnumerable[uint](100), Fibonacci), 4))
+	want := []uint{100, 0, 1, 1}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Logf("got: %v\nwant: %v", got, want)
+			t.FailNow()
+		}
+	}
+}
+
+func TestConcat_Error(t *testing.T) {
+	errBroken := errors.New("broken")
+	broken := FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) {
+			yield(0, errBroken)
+		}
+	})
+
+	got, err := ToSliceE(Concat(broken, AsEnumerable(2, 3)))
+	if !errors.Is(err, errBroken) {
+		t.Logf("got: %v\nwant: %v", err, errBroken)
+		t.Fail()
+	}
+	if len(got) != 1 {
+		t.Logf("got: %v\nwant: %v", got, []int{1})
+		t.Fail()
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	got := ToSlice(Interleave(AsEnumerable(1, 4, 6, 7), AsEnumerable[int](), AsEnumerable(2, 5), AsEnumerable(3)))
+	want := []int{1, 2, 3, 4, 5, 6, 7}
+
+	if len(got) != len(want) {
+		t.Logf("got: %v\nwant: %v", got, want)
+		t.FailNow()
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Logf("got: %v\nwant: %v", got, want)
+			t.FailNow()
+		}
+	}
+}
+
+func TestInterleave_Infinite(t *testing.T) {
+	got := ToSlice(Take(Interleave(RepeatForever(0), AsEnumerable(1, 2)), 6))
+	want := []int{0, 1, 0, 2, 0, 0}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Logf("got: %v\nwant: %v", got, want)
+			t.FailNow()
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package collection
+
+import (
+	"context"
+	"iter"
+	"runtime"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+// Cursor reads the values of an Enumerable one at a time, on the caller's goroutine. Unlike an Enumerator, a Cursor may
+// be abandoned part way through without leaking anything, as long as Close is called.
+//
+// A Cursor is not safe for concurrent use.
+type Cursor[T any] struct {
+	next     func() (T, bool)
+	stop     func()
+	cancel   context.CancelFunc
+	errs     func() error
+	err      error
+	finished bool
+	closed   bool
+}
+
+// cursorLeakReporter is called with the stack which opened a Cursor, when that Cursor is garbage collected without
+// having been closed or read to the end.
+var cursorLeakReporter atomic.Pointer[func(stack []byte)]
+
+// ReportCursorLeaks enables a debug mode, in which each Cursor that is garbage collected without having been closed or
+// read to the end is passed to `report`, along with the stack trace of the goroutine that opened it. Leaked Cursors are
+// closed once they have been reported. Passing nil disables the debug mode.
+//
+// Capturing a stack trace for each Cursor is expensive, so this is best suited to tests.
+func ReportCursorLeaks(report func(stack []byte)) {
+	if report == nil {
+		cursorLeakReporter.Store(nil)
+		return
+	}
+	cursorLeakReporter.Store(&report)
+}
+
+// Open begins reading an Enumerable, one value at a time. The Cursor must be closed once it is no longer needed,
+// unless it was read to the end.
+func Open[T any](subject Enumerable[T]) *Cursor[T] {
+	ctx, cancel := context.WithCancel(context.Background())
+	seq, errs := asSequence(ctx, subject)
+	next, stop := iter.Pull(seq)
+
+	retval := &Cursor[T]{
+		next:   next,
+		stop:   stop,
+		cancel: cancel,
+		errs:   errs,
+	}
+
+	if report := cursorLeakReporter.Load(); report != nil {
+		stack := debug.Stack()
+		runtime.SetFinalizer(retval, func(leaked *Cursor[T]) {
+			if !leaked.closed && !leaked.finished {
+				(*report)(stack)
+				leaked.Close()
+			}
+		})
+	}
+
+	return retval
+}
+
+// Close stops reading the Enumerable, and releases everything being used to read it. Calling Close more than once, or
+// after the Cursor has been read to the end, has no effect. The error returned is always nil; Err reports any error
+// encountered while reading.
+func (c *Cursor[T]) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	c.release()
+	return nil
+}
+
+// Err reports the error, if any, which ended enumeration early. It is only meaningful once Next has returned false.
+func (c *Cursor[T]) Err() error {
+	return c.err
+}
+
+// Next reads the next value. It returns false once there are no more values, because the Enumerable has ended, an
+// error was encountered, or the Cursor was closed.
+func (c *Cursor[T]) Next() (T, bool) {
+	if c.closed || c.finished {
+		return *new(T), false
+	}
+
+	current, ok := c.next()
+	if !ok {
+		c.finished = true
+		c.err = c.errs()
+		c.release()
+	}
+	return current, ok
+}
+
+func (c *Cursor[T]) release() {
+	c.cancel()
+	c.stop()
+}
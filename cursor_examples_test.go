@@ -0,0 +1,32 @@
+package collection_test
+
+import (
+	"fmt"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleOpen() {
+	cursor := collection.Open(collection.Fibonacci)
+	defer cursor.Close()
+
+	for {
+		current, ok := cursor.Next()
+		if !ok || current > 20 {
+			break
+		}
+		fmt.Println(current)
+	}
+	if err := cursor.Err(); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// 0
+	// 1
+	// 1
+	// 2
+	// 3
+	// 5
+	// 8
+	// 13
+}
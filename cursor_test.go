@@ -0,0 +1,163 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestCursor_CloseStopsUpstream(t *testing.T) {
+	stopped := make(chan struct{})
+	subject := FromSeq(func(yield func(int) bool) {
+		defer close(stopped)
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	cursor := Open(subject)
+	for i := 0; i < 3; i++ {
+		if got, ok := cursor.Next(); !ok || got != i {
+			t.Logf("got: %d, %v\nwant: %d, true", got, ok, i)
+			t.Fail()
+		}
+	}
+
+	if err := cursor.Close(); err != nil {
+		t.Error(err)
+	}
+	<-stopped
+
+	if _, ok := cursor.Next(); ok {
+		t.Log("a closed cursor should produce no more values")
+		t.Fail()
+	}
+	if err := cursor.Err(); err != nil {
+		t.Logf("closing a cursor should not be reported as an error, got: %v", err)
+		t.Fail()
+	}
+}
+
+func TestCursor_Err(t *testing.T) {
+	errBroken := errors.New("broken")
+	cursor := Open[int](FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) {
+			yield(0, errBroken)
+		}
+	}))
+	defer cursor.Close()
+
+	if got, ok := cursor.Next(); !ok || got != 1 {
+		t.Logf("got: %d, %v\nwant: %d, true", got, ok, 1)
+		t.Fail()
+	}
+	if _, ok := cursor.Next(); ok {
+		t.Log("the cursor should have ended")
+		t.Fail()
+	}
+	if err := cursor.Err(); !errors.Is(err, errBroken) {
+		t.Logf("got: %v\nwant: %v", err, errBroken)
+		t.Fail()
+	}
+}
+
+func TestCursor_PlainEnumerable(t *testing.T) {
+	var ctxErr error
+	done := make(chan struct{})
+	subject := plainEnumerable(func(ctx context.Context) Enumerator[int] {
+		results := make(chan int)
+		go func() {
+			defer close(done)
+			defer close(results)
+			for i := 0; ; i++ {
+				select {
+				case results <- i:
+					// Intentionally Left Blank
+				case <-ctx.Done():
+					ctxErr = ctx.Err()
+					return
+				}
+			}
+		}()
+		return results
+	})
+
+	if got, err := First[int](subject); err != nil || got != 0 {
+		t.Logf("got: %d, %v\nwant: %d, <nil>", got, err, 0)
+		t.Fail()
+	}
+
+	select {
+	case <-done:
+		// Intentionally Left Blank
+	case <-time.After(time.Second):
+		t.Log("producer goroutine was not released")
+		t.FailNow()
+	}
+	if !errors.Is(ctxErr, context.Canceled) {
+		t.Logf("got: %v\nwant: %v", ctxErr, context.Canceled)
+		t.Fail()
+	}
+}
+
+func TestReportCursorLeaks(t *testing.T) {
+	leaks := make(chan []byte, 1)
+	ReportCursorLeaks(func(stack []byte) {
+		leaks <- stack
+	})
+	defer ReportCursorLeaks(nil)
+
+	func() {
+		cursor := Open(Fibonacci)
+		cursor.Next()
+	}()
+
+	deadline := time.After(5 * time.Second)
+	for {
+		runtime.GC()
+		select {
+		case stack := <-leaks:
+			if len(stack) == 0 {
+				t.Log("the stack which opened the cursor should be reported")
+				t.Fail()
+			}
+			return
+		case <-deadline:
+			t.Log("leaked cursor was never reported")
+			t.FailNow()
+		case <-time.After(10 * time.Millisecond):
+			// Intentionally Left Blank
+		}
+	}
+}
+
+func TestSingle(t *testing.T) {
+	testCases := []struct {
+		subject Enumerable[int]
+		want    int
+		wantErr error
+	}{
+		{AsEnumerable[int](), 0, errNoElements},
+		{AsEnumerable(7), 7, nil},
+		{AsEnumerable(7, 8), 0, errMultipleElements},
+		{Where(Range(0, 100), func(x int) bool { return x > 1000 }), 0, errNoElements},
+	}
+
+	for _, tc := range testCases {
+		if got, err := Single(tc.subject); got != tc.want || err != tc.wantErr {
+			t.Logf("got: %d, %v\nwant: %d, %v", got, err, tc.want, tc.wantErr)
+			t.Fail()
+		}
+	}
+}
+
+// plainEnumerable adapts a function into an Enumerable which implements nothing else.
+type plainEnumerable func(ctx context.Context) Enumerator[int]
+
+func (p plainEnumerable) Enumerate(ctx context.Context) Enumerator[int] {
+	return p(ctx)
+}
@@ -2,6 +2,7 @@ package collection
 
 import (
 	"context"
+	"iter"
 	"sort"
 )
 
@@ -123,6 +124,16 @@ func (dict *Dictionary) Remove(word string) (wasRemoved bool) {
 	return
 }
 
+// Seq returns an iterator over each word in the Dictionary alphabetically.
+func (dict Dictionary) Seq() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if dict.root == nil {
+			return
+		}
+		dict.root.seq("", yield)
+	}
+}
+
 // Size reports the number of words there are in the Dictionary.
 //
 // Time complexity: O(1)
@@ -138,6 +149,28 @@ func (dict Dictionary) Enumerate(ctx context.Context) Enumerator[string] {
 	return dict.root.Enumerate(ctx)
 }
 
+// seq visits each word at or below this node alphabetically, returning false if yield asked for iteration to stop.
+func (node trieNode) seq(prefix string, yield func(string) bool) bool {
+	if node.IsWord && !yield(prefix) {
+		return false
+	}
+
+	alphabetizedChildren := []rune{}
+	for letter := range node.Children {
+		alphabetizedChildren = append(alphabetizedChildren, letter)
+	}
+	sort.Slice(alphabetizedChildren, func(i, j int) bool {
+		return alphabetizedChildren[i] < alphabetizedChildren[j]
+	})
+
+	for _, letter := range alphabetizedChildren {
+		if !node.Children[letter].seq(prefix+string(letter), yield) {
+			return false
+		}
+	}
+	return true
+}
+
 func (node trieNode) Enumerate(ctx context.Context) Enumerator[string] {
 	var enumerateHelper func(trieNode, string)
 
@@ -90,3 +90,19 @@ func ExampleDictionary_Remove() {
 	// 1
 	// true
 }
+
+func ExampleDictionary_Seq() {
+	subject := &collection.Dictionary{}
+	subject.Add("world")
+	subject.Add("hello")
+	subject.Add("help")
+
+	for word := range subject.Seq() {
+		fmt.Println(word)
+	}
+
+	// Output:
+	// hello
+	// help
+	// world
+}
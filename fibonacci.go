@@ -1,28 +1,11 @@
 package collection
 
-import "context"
-
-type fibonacciGenerator struct{}
-
 // Fibonacci is an Enumerable which will dynamically generate the fibonacci sequence.
-var Fibonacci Enumerable[uint] = fibonacciGenerator{}
-
-func (gen fibonacciGenerator) Enumerate(ctx context.Context) Enumerator[uint] {
-	retval := make(chan uint)
-
-	go func() {
-		defer close(retval)
-		var a, b uint = 0, 1
-
-		for {
-			select {
-			case retval <- a:
-				a, b = b, a+b
-			case <-ctx.Done():
-				return
-			}
-		}
-	}()
-
-	return retval
-}
+var Fibonacci Enumerable[uint] = Select(
+	Iterate(Pair[uint, uint]{First: 0, Second: 1}, func(current Pair[uint, uint]) Pair[uint, uint] {
+		return Pair[uint, uint]{First: current.Second, Second: current.First + current.Second}
+	}),
+	func(current Pair[uint, uint]) uint {
+		return current.First
+	},
+)
@@ -2,6 +2,7 @@ package collection
 
 import (
 	"context"
+	"iter"
 	"os"
 	"path/filepath"
 )
@@ -37,37 +38,53 @@ func (d Directory) applyOptions(loc string, info os.FileInfo) bool {
 
 // Enumerate lists the items in a `Directory`
 func (d Directory) Enumerate(ctx context.Context) Enumerator[string] {
-	results := make(chan string)
-
-	go func() {
-		defer close(results)
-
-		filepath.Walk(d.Location, func(currentLocation string, info os.FileInfo, openErr error) (err error) {
-			if openErr != nil {
-				err = openErr
-				return
-			}
-
-			if d.Location == currentLocation {
-				return
-			}
-
-			if info.IsDir() && d.Options&DirectoryOptionsRecursive == 0 {
-				err = filepath.SkipDir
-			}
-
-			if d.applyOptions(currentLocation, info) {
-				select {
-				case results <- currentLocation:
-					// Intentionally Left Blank
-				case <-ctx.Done():
-					err = ctx.Err()
-				}
-			}
+	return enumerateSequence[string](ctx, d)
+}
+
+// EnumerateE lists the items in a `Directory`, and reports the first error encountered while walking the filesystem.
+func (d Directory) EnumerateE(ctx context.Context) (Enumerator[string], func() error) {
+	return enumerateSequenceE[string](ctx, d)
+}
+
+// Seq returns an iterator over the items in a `Directory`. An error encountered while walking the filesystem silently
+// ends the iteration, use `EnumerateE` to observe it.
+func (d Directory) Seq() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		d.walk(context.Background(), yield)
+	}
+}
+
+func (d Directory) sequence(ctx context.Context) (iter.Seq[string], func() error) {
+	var err error
+	return func(yield func(string) bool) {
+		err = d.walk(ctx, yield)
+	}, func() error { return err }
+}
+
+// walk visits each item in a `Directory` until yield asks to stop, and returns the first error it encounters.
+func (d Directory) walk(ctx context.Context, yield func(string) bool) error {
+	return filepath.Walk(d.Location, func(currentLocation string, info os.FileInfo, openErr error) (err error) {
+		if openErr != nil {
+			err = openErr
+			return
+		}
 
+		if err = ctx.Err(); err != nil {
 			return
-		})
-	}()
+		}
+
+		if d.Location == currentLocation {
+			return
+		}
+
+		if info.IsDir() && d.Options&DirectoryOptionsRecursive == 0 {
+			err = filepath.SkipDir
+		}
+
+		if d.applyOptions(currentLocation, info) && !yield(currentLocation) {
+			err = filepath.SkipAll
+		}
 
-	return results
+		return
+	})
 }
@@ -131,3 +131,54 @@ func TestDirectory_Enumerate(t *testing.T) {
 		})
 	}
 }
+
+func TestDirectory_Seq(t *testing.T) {
+	subject := Directory{
+		Location: filepath.Join(".", "testdata", "foo"),
+		Options:  DirectoryOptionsRecursive,
+	}
+
+	want := ToSlice[string](subject)
+	got := []string{}
+	for entry := range subject.Seq() {
+		got = append(got, entry)
+	}
+
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Logf("got: %v\nwant: %v", got, want)
+		t.Fail()
+	}
+
+	seen := 0
+	for range subject.Seq() {
+		seen++
+		break
+	}
+	if seen != 1 {
+		t.Logf("got: %d\nwant: %d", seen, 1)
+		t.Fail()
+	}
+}
+
+func TestDirectory_EnumerateE(t *testing.T) {
+	subject := Directory{
+		Location: filepath.Join(".", "testdata", "missing"),
+	}
+
+	results, err := EnumerateE[string](context.Background(), Select[string](subject, filepath.Base))
+	for entry := range results {
+		t.Logf("unexpected result: %q", entry)
+		t.Fail()
+	}
+
+	if err() == nil {
+		t.Log("expected an error enumerating a directory that doesn't exist")
+		t.Fail()
+	}
+
+	subject.Location = filepath.Join(".", "testdata", "foo")
+	if _, err := ToSliceE[string](subject); err != nil {
+		t.Logf("unexpected error: %v", err)
+		t.Fail()
+	}
+}
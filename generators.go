@@ -0,0 +1,118 @@
+package collection
+
+import (
+	"context"
+	"iter"
+)
+
+type generator[T any] struct {
+	// start prepares the state of a single enumeration, and returns a function which produces each value in turn. The
+	// function reports false once there are no more values.
+	start func() func(context.Context) (T, bool)
+}
+
+// Generate creates an Enumerable which calls `next` to produce each value, until it reports that there are no more. It
+// is the most general way to build a custom source of values.
+//
+// The same function is called for every enumeration, so the resulting Enumerable is only reusable if `next` is.
+func Generate[T any](next func(ctx context.Context) (T, bool)) Enumerable[T] {
+	return generator[T]{
+		start: func() func(context.Context) (T, bool) {
+			return next
+		},
+	}
+}
+
+// Iterate creates a reusable, never-ending stream, which begins with `seed` and produces each subsequent value by
+// applying `next` to the one before it.
+func Iterate[T any](seed T, next func(T) T) Enumerable[T] {
+	return generator[T]{
+		start: func() func(context.Context) (T, bool) {
+			current, started := seed, false
+			return func(context.Context) (T, bool) {
+				if started {
+					current = next(current)
+				}
+				started = true
+				return current, true
+			}
+		},
+	}
+}
+
+// Range creates a reusable stream of `count` consecutive numbers, beginning with `start`.
+func Range[N Number](start N, count uint) Enumerable[N] {
+	return RangeStep(start, 1, count)
+}
+
+// RangeStep creates a reusable stream of `count` numbers, beginning with `start`, where each is `step` more than the
+// one before it. A negative step produces a descending stream.
+func RangeStep[N Number](start, step N, count uint) Enumerable[N] {
+	return generator[N]{
+		start: func() func(context.Context) (N, bool) {
+			var i uint
+			return func(context.Context) (N, bool) {
+				if i >= count {
+					return 0, false
+				}
+				current := start + N(i)*step
+				i++
+				return current, true
+			}
+		},
+	}
+}
+
+// Repeat creates a reusable stream which produces the same value `count` times.
+func Repeat[T any](value T, count uint) Enumerable[T] {
+	return generator[T]{
+		start: func() func(context.Context) (T, bool) {
+			var i uint
+			return func(context.Context) (T, bool) {
+				if i >= count {
+					return *new(T), false
+				}
+				i++
+				return value, true
+			}
+		},
+	}
+}
+
+// RepeatForever creates a reusable, never-ending stream which produces the same value over and over.
+func RepeatForever[T any](value T) Enumerable[T] {
+	return generator[T]{
+		start: func() func(context.Context) (T, bool) {
+			return func(context.Context) (T, bool) {
+				return value, true
+			}
+		},
+	}
+}
+
+func (g generator[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, g)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early. The only error that
+// will be reported is the cancellation of ctx.
+func (g generator[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, g)
+}
+
+func (g generator[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	var err error
+	return func(yield func(T) bool) {
+		next := g.start()
+		for {
+			if err = ctx.Err(); err != nil {
+				return
+			}
+
+			current, ok := next(ctx)
+			if !ok || !yield(current) {
+				return
+			}
+		}
+	}, func() error { return err }
+}
@@ -0,0 +1,53 @@
+package collection_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleGenerate() {
+	remaining := []string{"a", "b", "c"}
+	subject := collection.Generate(func(ctx context.Context) (string, bool) {
+		if len(remaining) == 0 {
+			return "", false
+		}
+		current := remaining[0]
+		remaining = remaining[1:]
+		return current, true
+	})
+
+	fmt.Println(collection.ToSlice(subject))
+	// Output: [a b c]
+}
+
+func ExampleIterate() {
+	powers := collection.Iterate(1, func(x int) int {
+		return x * 3
+	})
+
+	fmt.Println(collection.ToSlice(collection.Take(powers, 5)))
+	// Output: [1 3 9 27 81]
+}
+
+func ExampleRange() {
+	fmt.Println(collection.Sum(collection.Range(1, 100)))
+	// Output: 5050 <nil>
+}
+
+func ExampleRangeStep() {
+	fmt.Println(collection.ToSlice(collection.RangeStep(10, -2.5, 5)))
+	// Output: [10 7.5 5 2.5 0]
+}
+
+func ExampleRepeat() {
+	fmt.Println(collection.ToSlice(collection.Repeat("ho", 3)))
+	// Output: [ho ho ho]
+}
+
+func ExampleRepeatForever() {
+	ones := collection.RepeatForever(1)
+	fmt.Println(collection.Sum(collection.Take(ones, 7)))
+	// Output: 7 <nil>
+}
@@ -0,0 +1,120 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	testCases := []struct {
+		start int
+		step  int
+		count uint
+		want  []int
+	}{
+		{0, 1, 0, []int{}},
+		{3, 1, 4, []int{3, 4, 5, 6}},
+		{10, -3, 4, []int{10, 7, 4, 1}},
+		{-2, 2, 3, []int{-2, 0, 2}},
+	}
+
+	for _, tc := range testCases {
+		got := ToSlice(RangeStep(tc.start, tc.step, tc.count))
+		if len(got) != len(tc.want) {
+			t.Logf("got: %v\nwant: %v", got, tc.want)
+			t.Fail()
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Logf("got: %v\nwant: %v", got, tc.want)
+				t.Fail()
+				break
+			}
+		}
+	}
+}
+
+func TestIterate_Reusable(t *testing.T) {
+	doubles := Iterate(1, func(x int) int { return x * 2 })
+
+	for i := 0; i < 2; i++ {
+		got := ToSlice(Take(doubles, 5))
+		want := []int{1, 2, 4, 8, 16}
+		for j := range want {
+			if got[j] != want[j] {
+				t.Logf("enumeration %d\ngot: %v\nwant: %v", i, got, want)
+				t.Fail()
+				break
+			}
+		}
+	}
+}
+
+func TestIterate_Lazy(t *testing.T) {
+	calls := 0
+	subject := Iterate(0, func(x int) int {
+		calls++
+		return x + 1
+	})
+
+	ToSlice(Take(subject, 3))
+	if calls != 2 {
+		t.Logf("got: %d calls\nwant: %d calls", calls, 2)
+		t.Fail()
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	subject := Repeat("a", 3)
+
+	for i := 0; i < 2; i++ {
+		got := ToSlice(subject)
+		if len(got) != 3 {
+			t.Logf("enumeration %d\ngot: %v\nwant: %v", i, got, []string{"a", "a", "a"})
+			t.Fail()
+			continue
+		}
+		for _, entry := range got {
+			if entry != "a" {
+				t.Logf("enumeration %d\ngot: %v\nwant: %v", i, got, []string{"a", "a", "a"})
+				t.Fail()
+				break
+			}
+		}
+	}
+
+	if Any(Repeat("a", 0)) {
+		t.Log("no values should be produced for a count of zero")
+		t.Fail()
+	}
+}
+
+func TestRepeatForever_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	results, err := EnumerateE[string](ctx, RepeatForever("a"))
+	<-results
+	cancel()
+	for range results {
+		// Intentionally Left Blank
+	}
+
+	if got := err(); !errors.Is(got, context.Canceled) {
+		t.Logf("got: %v\nwant: %v", got, context.Canceled)
+		t.Fail()
+	}
+}
+
+func TestFibonacci(t *testing.T) {
+	got := ToSlice(Take(Fibonacci, 10))
+	want := []uint{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Logf("got: %v\nwant: %v", got, want)
+			t.FailNow()
+		}
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/marstr/collection/v2
 
-go 1.18
+go 1.23
@@ -0,0 +1,165 @@
+package collection
+
+import (
+	"context"
+	"iter"
+)
+
+// Grouping is a collection of values which share a common key.
+type Grouping[K comparable, T any] interface {
+	Enumerable[T]
+
+	// Key returns the value shared by each member of the Grouping.
+	Key() K
+}
+
+type grouping[K comparable, T any] struct {
+	key     K
+	members EnumerableSlice[T]
+}
+
+// Enumerate lists each member of the Grouping, in the order they were encountered.
+func (g grouping[K, T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return g.members.Enumerate(ctx)
+}
+
+// Key returns the value shared by each member of the Grouping.
+func (g grouping[K, T]) Key() K {
+	return g.key
+}
+
+// Seq returns an iterator over each member of the Grouping, in the order they were encountered.
+func (g grouping[K, T]) Seq() iter.Seq[T] {
+	return g.members.Seq()
+}
+
+type grouper[T any, K comparable] struct {
+	original Enumerable[T]
+	key      Transform[T, K]
+}
+
+// GroupBy creates a reusable stream which buckets the values of an Enumerable by the key selected from each of them.
+// Groupings are produced in the order their keys were first encountered, and the members of each Grouping keep the
+// order they were encountered in.
+//
+// Enumerating the result requires reading every value of subject before the first Grouping is produced.
+func GroupBy[T any, K comparable](subject Enumerable[T], key Transform[T, K]) Enumerable[Grouping[K, T]] {
+	return grouper[T, K]{
+		original: subject,
+		key:      key,
+	}
+}
+
+func (g grouper[T, K]) Enumerate(ctx context.Context) Enumerator[Grouping[K, T]] {
+	return enumerateSequence[Grouping[K, T]](ctx, g)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (g grouper[T, K]) EnumerateE(ctx context.Context) (Enumerator[Grouping[K, T]], func() error) {
+	return enumerateSequenceE[Grouping[K, T]](ctx, g)
+}
+
+func (g grouper[T, K]) sequence(ctx context.Context) (iter.Seq[Grouping[K, T]], func() error) {
+	original, err := asSequence(ctx, g.original)
+	return func(yield func(Grouping[K, T]) bool) {
+		keys, groups := group(original, g.key)
+		if err() != nil {
+			return
+		}
+
+		for _, key := range keys {
+			if !yield(groups[key]) {
+				return
+			}
+		}
+	}, err
+}
+
+// group buckets each value produced by an iterator by its key, and returns the keys in the order they were first
+// encountered.
+func group[T any, K comparable](seq iter.Seq[T], key Transform[T, K]) ([]K, map[K]grouping[K, T]) {
+	keys := []K{}
+	groups := make(map[K]grouping[K, T])
+
+	for entry := range seq {
+		k := key(entry)
+		current, ok := groups[k]
+		if !ok {
+			keys = append(keys, k)
+			current.key = k
+		}
+		current.members = append(current.members, entry)
+		groups[k] = current
+	}
+
+	return keys, groups
+}
+
+// Lookup is an immutable collection which maps each key to the values that share it. Unlike a GroupBy query, the
+// values of a Lookup are only gathered once, and it may be enumerated repeatedly without consulting its source again.
+type Lookup[K comparable, T any] struct {
+	keys   []K
+	groups map[K]grouping[K, T]
+}
+
+// ToLookup reads every value of an Enumerable, and buckets them by the key selected from each of them. If enumeration
+// ends early because ctx is cancelled or the Enumerable fails, no Lookup is returned, only the error.
+func ToLookup[T any, K comparable](ctx context.Context, subject Enumerable[T], key Transform[T, K]) (*Lookup[K, T], error) {
+	original, err := asSequence(ctx, subject)
+	keys, groups := group(original, key)
+	if err := err(); err != nil {
+		return nil, err
+	}
+
+	return &Lookup[K, T]{
+		keys:   keys,
+		groups: groups,
+	}, nil
+}
+
+// All returns an iterator over each key in the Lookup, and the values that share it, in the order the keys were first
+// encountered.
+func (l *Lookup[K, T]) All() iter.Seq2[K, Enumerable[T]] {
+	return func(yield func(K, Enumerable[T]) bool) {
+		for _, key := range l.keys {
+			if !yield(key, l.groups[key]) {
+				return
+			}
+		}
+	}
+}
+
+// Contains determines whether or not any values share the given key.
+func (l *Lookup[K, T]) Contains(key K) bool {
+	_, ok := l.groups[key]
+	return ok
+}
+
+// Enumerate lists each Grouping in the Lookup, in the order their keys were first encountered.
+func (l *Lookup[K, T]) Enumerate(ctx context.Context) Enumerator[Grouping[K, T]] {
+	return FromSeq(l.Seq()).Enumerate(ctx)
+}
+
+// Get retrieves the values which share a key. If no values share the key, an empty Enumerable is returned.
+func (l *Lookup[K, T]) Get(key K) Enumerable[T] {
+	if current, ok := l.groups[key]; ok {
+		return current
+	}
+	return Empty[T]()
+}
+
+// Length returns the number of distinct keys in the Lookup.
+func (l *Lookup[K, T]) Length() uint {
+	return uint(len(l.keys))
+}
+
+// Seq returns an iterator over each Grouping in the Lookup, in the order their keys were first encountered.
+func (l *Lookup[K, T]) Seq() iter.Seq[Grouping[K, T]] {
+	return func(yield func(Grouping[K, T]) bool) {
+		for _, key := range l.keys {
+			if !yield(l.groups[key]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package collection_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleGroupBy() {
+	subject := collection.AsEnumerable("apple", "avocado", "banana", "blueberry", "cherry", "apricot")
+	groups := collection.GroupBy(subject, func(fruit string) byte {
+		return fruit[0]
+	})
+
+	for group := range groups.Enumerate(context.Background()) {
+		fmt.Printf("%c %v\n", group.Key(), collection.ToSlice[string](group))
+	}
+	// Output:
+	// a [apple avocado apricot]
+	// b [banana blueberry]
+	// c [cherry]
+}
+
+func ExampleToLookup() {
+	subject := collection.Take(collection.Fibonacci, 10)
+	parity, err := collection.ToLookup(context.Background(), subject, func(x uint) bool {
+		return x%2 == 0
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(parity.Length())
+	fmt.Println(collection.ToSlice(parity.Get(true)))
+	fmt.Println(collection.ToSlice(parity.Get(false)))
+	// Output:
+	// 2
+	// [0 2 8 34]
+	// [1 1 3 5 13 21]
+}
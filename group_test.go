@@ -0,0 +1,84 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestLookup_Reenumerate(t *testing.T) {
+	pulls := 0
+	subject := FromSeq(func(yield func(int) bool) {
+		for i := 0; i < 6; i++ {
+			pulls++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	lookup, err := ToLookup(context.Background(), subject, func(x int) int {
+		return x % 3
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for i := 0; i < 2; i++ {
+		want := "[0 1 2]"
+		got := fmt.Sprint(ToSlice(Select(lookup, func(g Grouping[int, int]) int {
+			return g.Key()
+		})))
+		if got != want {
+			t.Logf("got: %s\nwant: %s", got, want)
+			t.Fail()
+		}
+	}
+
+	if pulls != 6 {
+		t.Logf("source should only have been read once, got %d pulls", pulls)
+		t.Fail()
+	}
+
+	if lookup.Contains(3) {
+		t.Log("unexpected key 3")
+		t.Fail()
+	}
+
+	if Any(lookup.Get(3)) {
+		t.Log("missing key should produce an empty Enumerable")
+		t.Fail()
+	}
+
+	for key, values := range lookup.All() {
+		want := fmt.Sprint([]int{key, key + 3})
+		if got := fmt.Sprint(ToSlice(values)); got != want {
+			t.Logf("got: %s\nwant: %s", got, want)
+			t.Fail()
+		}
+	}
+}
+
+func TestToLookup_Failure(t *testing.T) {
+	want := errors.New("source failed")
+	subject := FromSeqE(func(yield func(int, error) bool) {
+		if !yield(1, nil) {
+			return
+		}
+		yield(0, want)
+	})
+
+	lookup, err := ToLookup(context.Background(), subject, func(x int) int {
+		return x % 2
+	})
+	if !errors.Is(err, want) {
+		t.Logf("got: %v\nwant: %v", err, want)
+		t.Fail()
+	}
+	if lookup != nil {
+		t.Log("no Lookup should be built from a truncated source")
+		t.Fail()
+	}
+}
@@ -0,0 +1,105 @@
+package collection
+
+import (
+	"context"
+	"iter"
+)
+
+type joiner[O any, I any, K comparable, R any] struct {
+	outer    Enumerable[O]
+	inner    Enumerable[I]
+	outerKey Transform[O, K]
+	innerKey Transform[I, K]
+	combine  func(outer O, matches EnumerableSlice[I], yield func(R) bool) bool
+}
+
+// Join correlates the values of two Enumerables which share a key, producing one result for each pair of matching
+// values. Values of outer which have no match in inner are omitted.
+//
+// Each enumeration reads all of inner once, to build a hash table keyed by innerKey, then streams outer. Results are
+// produced in the order of outer, then in the order of inner for values of outer which have several matches.
+func Join[O any, I any, K comparable, R any](outer Enumerable[O], inner Enumerable[I], outerKey Transform[O, K], innerKey Transform[I, K], resultSelector func(O, I) R) Enumerable[R] {
+	return joiner[O, I, K, R]{
+		outer:    outer,
+		inner:    inner,
+		outerKey: outerKey,
+		innerKey: innerKey,
+		combine: func(outer O, matches EnumerableSlice[I], yield func(R) bool) bool {
+			for _, match := range matches {
+				if !yield(resultSelector(outer, match)) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
+
+// GroupJoin correlates the values of two Enumerables which share a key, producing one result for each value of outer
+// along with all of the values of inner which matched it. Values of outer which have no match in inner are paired
+// with an empty Enumerable.
+//
+// Each enumeration reads all of inner once, to build a hash table keyed by innerKey, then streams outer.
+func GroupJoin[O any, I any, K comparable, R any](outer Enumerable[O], inner Enumerable[I], outerKey Transform[O, K], innerKey Transform[I, K], resultSelector func(O, Enumerable[I]) R) Enumerable[R] {
+	return joiner[O, I, K, R]{
+		outer:    outer,
+		inner:    inner,
+		outerKey: outerKey,
+		innerKey: innerKey,
+		combine: func(outer O, matches EnumerableSlice[I], yield func(R) bool) bool {
+			return yield(resultSelector(outer, matches))
+		},
+	}
+}
+
+// LeftJoin correlates the values of two Enumerables which share a key, producing one result for each pair of matching
+// values. Unlike Join, values of outer which have no match in inner still produce a single result, with the zero value
+// of I and `matched` set to false.
+//
+// Each enumeration reads all of inner once, to build a hash table keyed by innerKey, then streams outer.
+func LeftJoin[O any, I any, K comparable, R any](outer Enumerable[O], inner Enumerable[I], outerKey Transform[O, K], innerKey Transform[I, K], resultSelector func(outer O, inner I, matched bool) R) Enumerable[R] {
+	return joiner[O, I, K, R]{
+		outer:    outer,
+		inner:    inner,
+		outerKey: outerKey,
+		innerKey: innerKey,
+		combine: func(outer O, matches EnumerableSlice[I], yield func(R) bool) bool {
+			if len(matches) == 0 {
+				return yield(resultSelector(outer, *new(I), false))
+			}
+			for _, match := range matches {
+				if !yield(resultSelector(outer, match, true)) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
+
+func (j joiner[O, I, K, R]) Enumerate(ctx context.Context) Enumerator[R] {
+	return enumerateSequence[R](ctx, j)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration of either side early.
+func (j joiner[O, I, K, R]) EnumerateE(ctx context.Context) (Enumerator[R], func() error) {
+	return enumerateSequenceE[R](ctx, j)
+}
+
+func (j joiner[O, I, K, R]) sequence(ctx context.Context) (iter.Seq[R], func() error) {
+	inner, innerErr := asSequence(ctx, j.inner)
+	outer, outerErr := asSequence(ctx, j.outer)
+
+	return func(yield func(R) bool) {
+		_, lookup := group(inner, j.innerKey)
+		if innerErr() != nil {
+			return
+		}
+
+		for entry := range outer {
+			if !j.combine(entry, lookup[j.outerKey(entry)].members, yield) {
+				return
+			}
+		}
+	}, firstError(innerErr, outerErr)
+}
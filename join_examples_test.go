@@ -0,0 +1,98 @@
+package collection_test
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/marstr/collection/v2"
+)
+
+type employee struct {
+	Name       string
+	Department int
+}
+
+type department struct {
+	ID   int
+	Name string
+}
+
+var (
+	exampleEmployees = collection.AsEnumerable(
+		employee{"Ada", 1},
+		employee{"Grace", 2},
+		employee{"Linus", 3},
+		employee{"Ken", 1},
+	)
+
+	exampleDepartments = collection.AsEnumerable(
+		department{1, "Compilers"},
+		department{2, "Languages"},
+		department{4, "Kernels"},
+	)
+)
+
+func ExampleJoin() {
+	expected := &collection.Dictionary{}
+	expected.Add("a.txt")
+	expected.Add("b.txt")
+
+	files := collection.Directory{
+		Location: filepath.Join("testdata", "foo"),
+		Options:  collection.DirectoryOptionsExcludeDirectories | collection.DirectoryOptionsRecursive,
+	}
+
+	found := collection.Join[string, string, string, string](
+		files,
+		expected,
+		filepath.Base,
+		collection.Identity[string](),
+		func(path string, name string) string {
+			return filepath.ToSlash(path)
+		})
+
+	fmt.Println(collection.ToSlice(found))
+	// Output: [testdata/foo/a.txt testdata/foo/bar/b.txt]
+}
+
+func ExampleGroupJoin() {
+	staffing := collection.GroupJoin(
+		exampleDepartments,
+		exampleEmployees,
+		func(d department) int { return d.ID },
+		func(e employee) int { return e.Department },
+		func(d department, staff collection.Enumerable[employee]) string {
+			return fmt.Sprintf("%s: %d", d.Name, collection.CountAll(staff))
+		})
+
+	for _, line := range collection.ToSlice(staffing) {
+		fmt.Println(line)
+	}
+	// Output:
+	// Compilers: 2
+	// Languages: 1
+	// Kernels: 0
+}
+
+func ExampleLeftJoin() {
+	assignments := collection.LeftJoin(
+		exampleEmployees,
+		exampleDepartments,
+		func(e employee) int { return e.Department },
+		func(d department) int { return d.ID },
+		func(e employee, d department, matched bool) string {
+			if !matched {
+				return e.Name + " is unassigned"
+			}
+			return e.Name + " works on " + d.Name
+		})
+
+	for _, line := range collection.ToSlice(assignments) {
+		fmt.Println(line)
+	}
+	// Output:
+	// Ada works on Compilers
+	// Grace works on Languages
+	// Linus is unassigned
+	// Ken works on Compilers
+}
@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestJoin_InnerReadOnce(t *testing.T) {
+	innerPulls := 0
+	inner := FromSeq(func(yield func(int) bool) {
+		for i := 0; i < 4; i++ {
+			innerPulls++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	joined := Join(AsEnumerable(3, 1, 1, 7), inner, Identity[int](), Identity[int](), func(a, b int) int {
+		return a + b
+	})
+
+	want := []int{6, 2, 2}
+	got := ToSlice(joined)
+	if len(got) != len(want) {
+		t.Logf("got: %v\nwant: %v", got, want)
+		t.FailNow()
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Logf("got: %v\nwant: %v", got, want)
+			t.Fail()
+		}
+	}
+
+	if innerPulls != 4 {
+		t.Logf("inner should have been read exactly once, got %d pulls", innerPulls)
+		t.Fail()
+	}
+}
+
+func TestJoin_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	outer := FromSeq(func(yield func(uint) bool) {
+		for i := uint(0); yield(i); i++ {
+			// Intentionally Left Blank
+		}
+	})
+
+	joined := Join[uint, uint, uint, uint](outer, Take(Fibonacci, 20), Identity[uint](), Identity[uint](), func(a, b uint) uint {
+		return a
+	})
+
+	results, err := EnumerateE(ctx, joined)
+	<-results
+	cancel()
+	results.Discard()
+
+	if got := err(); !errors.Is(got, context.Canceled) {
+		t.Logf("got: %v\nwant: %v", got, context.Canceled)
+		t.Fail()
+	}
+}
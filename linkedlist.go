@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"sync"
 )
 
@@ -121,6 +122,37 @@ func (list *LinkedList[T]) Enumerate(ctx context.Context) Enumerator[T] {
 	return retval
 }
 
+// All returns an iterator over each index and value in the LinkedList, from front to back.
+//
+// The LinkedList is locked for reading while the iterator runs, so it must not be modified from within the loop body.
+func (list *LinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		list.key.RLock()
+		defer list.key.RUnlock()
+
+		i := 0
+		for current := list.first; current != nil; current = current.next {
+			if !yield(i, current.payload) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Seq returns an iterator over each value in the LinkedList, from front to back.
+//
+// The LinkedList is locked for reading while the iterator runs, so it must not be modified from within the loop body.
+func (list *LinkedList[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, entry := range list.All() {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
 // Get finds the value from the LinkedList.
 // pos is expressed as a zero-based index begining from the 'front' of the list.
 func (list *LinkedList[T]) Get(pos uint) (T, bool) {
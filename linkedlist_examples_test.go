@@ -92,3 +92,27 @@ func ExampleLinkedList_Swap() {
 	fmt.Println(subject)
 	// Output: [2 8 5 3 13]
 }
+
+func ExampleLinkedList_All() {
+	subject := collection.NewLinkedList("a", "b", "c")
+	for i, entry := range subject.All() {
+		fmt.Println(i, entry)
+	}
+	// Output:
+	// 0 a
+	// 1 b
+	// 2 c
+}
+
+func ExampleLinkedList_Seq() {
+	subject := collection.NewLinkedList(2, 3, 5, 8)
+	for entry := range subject.Seq() {
+		if entry > 3 {
+			break
+		}
+		fmt.Println(entry)
+	}
+	// Output:
+	// 2
+	// 3
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"iter"
 	"sync"
 )
 
@@ -38,6 +39,22 @@ func (l *List[T]) AddAt(pos uint, entries ...T) {
 	l.underlyer = append(l.underlyer[:pos], append(entries, l.underlyer[pos:]...)...)
 }
 
+// All returns an iterator over each index and value in the List.
+//
+// The List is locked for reading while the iterator runs, so it must not be modified from within the loop body.
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		l.key.RLock()
+		defer l.key.RUnlock()
+
+		for i, entry := range l.underlyer {
+			if !yield(i, entry) {
+				return
+			}
+		}
+	}
+}
+
 // Enumerate lists each element present in the collection
 func (l *List[T]) Enumerate(ctx context.Context) Enumerator[T] {
 	retval := make(chan T)
@@ -100,6 +117,19 @@ func (l *List[T]) Remove(pos uint) (T, bool) {
 	return retval, true
 }
 
+// Seq returns an iterator over each value in the List.
+//
+// The List is locked for reading while the iterator runs, so it must not be modified from within the loop body.
+func (l *List[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, entry := range l.All() {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
 // Set updates the value stored at a given position in the List.
 func (l *List[T]) Set(pos uint, val T) bool {
 	l.key.Lock()
@@ -8,3 +8,14 @@ func ExampleList_AddAt() {
 	fmt.Println(subject)
 	// Output: [0 1 2 3 4 5 6]
 }
+
+func ExampleList_Seq() {
+	subject := NewList(1, 2, 3)
+	for entry := range subject.Seq() {
+		fmt.Println(entry)
+	}
+	// Output:
+	// 1
+	// 2
+	// 3
+}
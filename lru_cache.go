@@ -2,6 +2,7 @@ package collection
 
 import (
 	"context"
+	"iter"
 	"sync"
 )
 
@@ -87,6 +88,22 @@ func (lru *LRUCache[K, V]) Remove(key K) bool {
 	return true
 }
 
+// All returns an iterator over each key and value in the cache, from most to least recently used.
+//
+// The cache is locked for reading while the iterator runs, so it must not be modified from within the loop body.
+func (lru *LRUCache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		lru.key.RLock()
+		defer lru.key.RUnlock()
+
+		for entry := range lru.touched.Seq() {
+			if !yield(entry.Key, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
 // Enumerate lists each value in the cache.
 func (lru *LRUCache[K, V]) Enumerate(ctx context.Context) Enumerator[V] {
 	retval := make(chan V)
@@ -134,3 +151,16 @@ func (lru *LRUCache[K, V]) EnumerateKeys(ctx context.Context) Enumerator[K] {
 
 	return retval
 }
+
+// Seq returns an iterator over each value in the cache, from most to least recently used.
+//
+// The cache is locked for reading while the iterator runs, so it must not be modified from within the loop body.
+func (lru *LRUCache[K, V]) Seq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range lru.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
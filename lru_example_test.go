@@ -52,3 +52,20 @@ func ExampleLRUCache_EnumerateKeys() {
 	// 3
 	// 2
 }
+
+func ExampleLRUCache_All() {
+	subject := collection.NewLRUCache[int, string](3)
+	subject.Put(1, "one")
+	subject.Put(2, "two")
+	subject.Put(3, "three")
+	subject.Put(4, "four")
+
+	for key, value := range subject.All() {
+		fmt.Println(key, value)
+	}
+
+	// Output:
+	// 4 four
+	// 3 three
+	// 2 two
+}
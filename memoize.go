@@ -0,0 +1,240 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+)
+
+// A collection of errors that may be thrown by functions in this file.
+var (
+	ErrEvicted = errors.New("value was evicted from the memoized cache before it could be replayed")
+)
+
+// Memoized is a reusable Enumerable which reads its source at most once. Values are cached as they are first
+// requested, so consumers which arrive later, or which fall behind, replay the cache before continuing to pull from the
+// shared source.
+//
+// The source is enumerated on its own goroutine, which runs until the source ends or Close is called. A consumer
+// waiting for the source to produce its next value stops waiting as soon as its context is cancelled.
+type Memoized[T any] struct {
+	source  Enumerable[T]
+	limit   uint
+	ctx     context.Context
+	cancel  context.CancelFunc
+	started sync.Once
+
+	// demand is signalled whenever a consumer raises wanted, so that the goroutine reading the source knows to read
+	// another value.
+	demand chan struct{}
+
+	// key guards the fields below, which are shared between the consumers and the goroutine reading the source.
+	key sync.RWMutex
+
+	// cache holds the most recently read values. When there is a limit, it is used as a ring, so the value at position
+	// i of the source is held at i % limit.
+	cache   []T
+	read    uint64
+	wanted  uint64
+	changed chan struct{}
+	done    bool
+	err     error
+}
+
+// Memoize creates a reusable stream which reads `subject` lazily, and no more than once, no matter how many times it
+// is enumerated. Every value read is cached for as long as the Memoized is, so it is best suited to Enumerables which
+// are expensive to produce, or which can only be enumerated once.
+func Memoize[T any](subject Enumerable[T]) *Memoized[T] {
+	return MemoizeBounded(subject, 0)
+}
+
+// MemoizeBounded behaves like Memoize, but only retains the `limit` most recently read values. A consumer which falls
+// so far behind that the value it needs has been evicted stops, and ErrEvicted is reported by EnumerateE. A limit of
+// zero retains every value.
+func MemoizeBounded[T any](subject Enumerable[T], limit uint) *Memoized[T] {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Memoized[T]{
+		source:  subject,
+		limit:   limit,
+		ctx:     ctx,
+		cancel:  cancel,
+		demand:  make(chan struct{}, 1),
+		changed: make(chan struct{}),
+	}
+}
+
+// Memoize creates a reusable stream which reads the values of this Enumerator lazily, as they are first requested.
+// Unlike AsEnumerable, it returns immediately, so it is safe to use with Enumerators which never end.
+func (iter Enumerator[T]) Memoize() *Memoized[T] {
+	return Memoize(FromSeq(func(yield func(T) bool) {
+		for entry := range iter {
+			if !yield(entry) {
+				return
+			}
+		}
+	}))
+}
+
+// Close stops reading from the source and releases the goroutine enumerating it. Values which have already been cached
+// may still be replayed, but consumers which reach the end of the cache stop there, and context.Canceled is reported
+// by EnumerateE.
+func (m *Memoized[T]) Close() error {
+	m.cancel()
+	m.finish(context.Canceled)
+	return nil
+}
+
+// Enumerate replays the values which have already been read from the source, then continues reading from it.
+func (m *Memoized[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, m)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early. This includes any
+// error which ended the source, which is reported to every consumer which reaches that point.
+func (m *Memoized[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, m)
+}
+
+func (m *Memoized[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	var err error
+	return func(yield func(T) bool) {
+		for i := uint64(0); ; i++ {
+			if err = ctx.Err(); err != nil {
+				return
+			}
+
+			current, ok, cause := m.get(ctx, i)
+			if !ok {
+				err = cause
+				return
+			}
+
+			if !yield(current) {
+				return
+			}
+		}
+	}, func() error { return err }
+}
+
+// get finds the value at a particular position in the source, waiting for it to be read if necessary. It reports false
+// if there is no such value, or ctx was cancelled while waiting for it, along with the reason why.
+func (m *Memoized[T]) get(ctx context.Context, i uint64) (T, bool, error) {
+	for {
+		current, found, ended, err, changed := m.cached(i)
+		if found || ended {
+			return current, found, err
+		}
+
+		m.started.Do(func() {
+			go m.pump()
+		})
+		select {
+		case m.demand <- struct{}{}:
+			// Intentionally Left Blank
+		default:
+			// Intentionally Left Blank
+		}
+
+		select {
+		case <-changed:
+			// Intentionally Left Blank
+		case <-ctx.Done():
+			return current, false, ctx.Err()
+		}
+	}
+}
+
+// cached finds the value at a particular position if it has already been read. It reports whether or not the value
+// was found, and if not, whether or not it ever will be, along with the reason why. If the value may still be read, it
+// is recorded as wanted, and a channel is returned which will be closed once more is known.
+func (m *Memoized[T]) cached(i uint64) (current T, found bool, ended bool, err error, changed <-chan struct{}) {
+	m.key.Lock()
+	defer m.key.Unlock()
+
+	if i < m.read-uint64(len(m.cache)) {
+		return current, false, true, ErrEvicted, nil
+	}
+	if i < m.read {
+		if m.limit > 0 {
+			i %= uint64(m.limit)
+		}
+		return m.cache[i], true, false, nil, nil
+	}
+	if m.done {
+		return current, false, true, m.err, nil
+	}
+
+	m.wanted = max(m.wanted, i+1)
+	return current, false, false, nil, m.changed
+}
+
+// pump reads values from the source into the cache, one at a time, as they are wanted by consumers.
+func (m *Memoized[T]) pump() {
+	source, sourceErr := asSequence(m.ctx, m.source)
+	for entry := range source {
+		m.record(entry)
+		if !m.awaitDemand() {
+			break
+		}
+	}
+
+	err := sourceErr()
+	if err == nil {
+		err = m.ctx.Err()
+	}
+	m.finish(err)
+}
+
+// awaitDemand waits until a consumer wants a value which has not been read yet. It reports false if the Memoized is
+// closed first.
+func (m *Memoized[T]) awaitDemand() bool {
+	for {
+		m.key.RLock()
+		wanted := m.wanted > m.read
+		m.key.RUnlock()
+		if wanted {
+			return true
+		}
+
+		select {
+		case <-m.demand:
+			// Intentionally Left Blank
+		case <-m.ctx.Done():
+			return false
+		}
+	}
+}
+
+// record adds a value which has just been read from the source to the cache, and wakes any consumers waiting for it.
+func (m *Memoized[T]) record(entry T) {
+	m.key.Lock()
+	defer m.key.Unlock()
+
+	if m.done {
+		return
+	}
+
+	if m.limit == 0 || uint(len(m.cache)) < m.limit {
+		m.cache = append(m.cache, entry)
+	} else {
+		m.cache[m.read%uint64(m.limit)] = entry
+	}
+	m.read++
+
+	close(m.changed)
+	m.changed = make(chan struct{})
+}
+
+// finish marks the source as having ended, and wakes any consumers waiting for more values.
+func (m *Memoized[T]) finish(err error) {
+	m.key.Lock()
+	defer m.key.Unlock()
+
+	if m.done {
+		return
+	}
+	m.done = true
+	m.err = err
+	close(m.changed)
+}
@@ -0,0 +1,38 @@
+package collection_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleMemoize() {
+	reads := 0
+	expensive := collection.Select(collection.Fibonacci, func(x uint) uint {
+		reads++
+		return x
+	})
+
+	subject := collection.Memoize(expensive)
+	defer subject.Close()
+
+	fmt.Println(collection.ToSlice(collection.Take[uint](subject, 5)))
+	fmt.Println(collection.ToSlice(collection.Take[uint](subject, 7)))
+	fmt.Println(reads)
+	// Output:
+	// [0 1 1 2 3]
+	// [0 1 1 2 3 5 8]
+	// 7
+}
+
+func ExampleEnumerator_Memoize() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subject := collection.Fibonacci.Enumerate(ctx).Memoize()
+	defer subject.Close()
+
+	fmt.Println(collection.Sum(collection.Take[uint](subject, 10)))
+	// Output: 88 <nil>
+}
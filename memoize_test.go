@@ -0,0 +1,223 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoize_ReadsSourceOnce(t *testing.T) {
+	var calls int32
+	subject := Memoize(Select(Range(0, 10), func(x int) int {
+		atomic.AddInt32(&calls, 1)
+		return x
+	}))
+	defer subject.Close()
+
+	for i := 0; i < 3; i++ {
+		if got, err := Sum[int](subject); err != nil || got != 45 {
+			t.Logf("got: %d %v\nwant: %d", got, err, 45)
+			t.Fail()
+		}
+	}
+
+	if calls != 10 {
+		t.Logf("got: %d calls\nwant: %d calls", calls, 10)
+		t.Fail()
+	}
+}
+
+func TestMemoize_Lazy(t *testing.T) {
+	var calls int32
+	subject := Memoize(Select(Fibonacci, func(x uint) uint {
+		atomic.AddInt32(&calls, 1)
+		return x
+	}))
+	defer subject.Close()
+
+	if got := ToSlice(Take[uint](subject, 3)); len(got) != 3 {
+		t.Logf("got: %v\nwant: 3 values", got)
+		t.Fail()
+	}
+	if got := ToSlice(Take[uint](subject, 5)); got[4] != 3 {
+		t.Logf("got: %v\nwant: %v", got, []uint{0, 1, 1, 2, 3})
+		t.Fail()
+	}
+
+	if calls != 5 {
+		t.Logf("got: %d calls\nwant: %d calls", calls, 5)
+		t.Fail()
+	}
+}
+
+func TestMemoize_Concurrent(t *testing.T) {
+	subject := Memoize(Range(0, 1000))
+	defer subject.Close()
+
+	var wg sync.WaitGroup
+	sums := make([]int, 8)
+	errs := make([]error, len(sums))
+	for i := range sums {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sums[i], errs[i] = Sum[int](subject)
+		}()
+	}
+	wg.Wait()
+
+	for i, got := range sums {
+		if errs[i] != nil || got != 499500 {
+			t.Logf("consumer %d\ngot: %d %v\nwant: %d", i, got, errs[i], 499500)
+			t.Fail()
+		}
+	}
+}
+
+func TestMemoize_Error(t *testing.T) {
+	errBroken := errors.New("broken")
+	var calls int32
+	subject := Memoize[int](FromSeqE(func(yield func(int, error) bool) {
+		atomic.AddInt32(&calls, 1)
+		if yield(1, nil) {
+			yield(0, errBroken)
+		}
+	}))
+	defer subject.Close()
+
+	for i := 0; i < 2; i++ {
+		got, err := ToSliceE[int](subject)
+		if !errors.Is(err, errBroken) {
+			t.Logf("got: %v\nwant: %v", err, errBroken)
+			t.Fail()
+		}
+		if len(got) != 1 {
+			t.Logf("got: %v\nwant: %v", got, []int{1})
+			t.Fail()
+		}
+	}
+
+	if calls != 1 {
+		t.Logf("got: %d calls\nwant: %d calls", calls, 1)
+		t.Fail()
+	}
+}
+
+func TestMemoizeBounded_Evicted(t *testing.T) {
+	subject := MemoizeBounded(Range(0, 10), 3)
+	defer subject.Close()
+
+	if got := ToSlice[int](subject); len(got) != 10 {
+		t.Logf("got: %v\nwant: 10 values", got)
+		t.Fail()
+	}
+
+	got, err := ToSliceE[int](subject)
+	if err != ErrEvicted {
+		t.Logf("got: %v\nwant: %v", err, ErrEvicted)
+		t.Fail()
+	}
+	if len(got) != 0 {
+		t.Logf("got: %v\nwant: no values", got)
+		t.Fail()
+	}
+}
+
+func TestMemoizeBounded_Ring(t *testing.T) {
+	subject := MemoizeBounded(Range(0, 100), 3)
+	defer subject.Close()
+
+	// Values are still read in order once the cache has wrapped around many times.
+	results, err := subject.EnumerateE(context.Background())
+	want := 0
+	for got := range results {
+		if got != want {
+			t.Logf("got: %d\nwant: %d", got, want)
+			t.Fail()
+		}
+		want++
+	}
+	if err() != nil || want != 100 {
+		t.Logf("got: %d values, %v\nwant: %d values, %v", want, err(), 100, nil)
+		t.Fail()
+	}
+
+	// Only the three most recent values are retained.
+	if subject.read != 100 || len(subject.cache) != 3 {
+		t.Logf("got: %d read, %d cached\nwant: %d read, %d cached", subject.read, len(subject.cache), 100, 3)
+		t.Fail()
+	}
+}
+
+func TestMemoize_Close(t *testing.T) {
+	subject := Memoize(Fibonacci)
+	ToSlice(Take[uint](subject, 3))
+
+	if err := subject.Close(); err != nil {
+		t.Error(err)
+	}
+
+	results, err := EnumerateE[uint](context.Background(), subject)
+	got := results.ToSlice()
+	if len(got) != 3 {
+		t.Logf("got: %v\nwant: 3 values", got)
+		t.Fail()
+	}
+	if !errors.Is(err(), context.Canceled) {
+		t.Logf("got: %v\nwant: %v", err(), context.Canceled)
+		t.Fail()
+	}
+}
+
+func TestMemoize_CancelledWhileSourceBlocked(t *testing.T) {
+	subject := Memoize(Generate(func(ctx context.Context) (int, bool) {
+		<-ctx.Done()
+		return 0, false
+	}))
+	defer subject.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	results, err := subject.EnumerateE(ctx)
+
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		results.Discard()
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-finished:
+		// Intentionally Left Blank
+	case <-time.After(time.Second):
+		t.Log("consumer kept waiting on the source after its context was cancelled")
+		t.FailNow()
+	}
+
+	if !errors.Is(err(), context.Canceled) {
+		t.Logf("got: %v\nwant: %v", err(), context.Canceled)
+		t.Fail()
+	}
+}
+
+func TestEnumerator_Memoize(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subject := Fibonacci.Enumerate(ctx).Memoize()
+	defer subject.Close()
+
+	first := ToSlice(Take[uint](subject, 4))
+	second := ToSlice(Take[uint](subject, 4))
+	for i := range first {
+		if first[i] != second[i] {
+			t.Logf("got: %v\nwant: %v", second, first)
+			t.FailNow()
+		}
+	}
+}
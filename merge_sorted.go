@@ -0,0 +1,168 @@
+package collection
+
+import (
+	"container/heap"
+	"context"
+	"iter"
+)
+
+type sortedMerger[T any] struct {
+	sources []Enumerable[T]
+	compare Comparator[T]
+	unique  bool
+}
+
+// MergeSorted creates a reusable stream which combines several Enumerables, each of which must already be sorted
+// according to `comparator`, into a single sorted stream. Values are read lazily, only one value is held from each
+// source at a time, so sources which never end are supported. Equal values are produced in the order their sources
+// were provided.
+//
+// If comparator returns an error, enumeration stops and that error is reported by EnumerateE.
+func MergeSorted[T any](comparator Comparator[T], sources ...Enumerable[T]) EnumerableE[T] {
+	return sortedMerger[T]{
+		sources: sources,
+		compare: comparator,
+	}
+}
+
+// MergeSortedUnique behaves like MergeSorted, but only produces the first of any run of values which comparator
+// considers equal, whether they come from the same source or different ones.
+func MergeSortedUnique[T any](comparator Comparator[T], sources ...Enumerable[T]) EnumerableE[T] {
+	return sortedMerger[T]{
+		sources: sources,
+		compare: comparator,
+		unique:  true,
+	}
+}
+
+func (m sortedMerger[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, m)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early, including errors
+// returned by the comparator.
+func (m sortedMerger[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, m)
+}
+
+func (m sortedMerger[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	sources := make([]iter.Seq[T], len(m.sources))
+	sourceErrs := make([]func() error, len(m.sources)+1)
+	for i, source := range m.sources {
+		sources[i], sourceErrs[i] = asSequence(ctx, source)
+	}
+
+	var compareErr error
+	sourceErrs[len(m.sources)] = func() error { return compareErr }
+	errs := firstError(sourceErrs...)
+
+	return func(yield func(T) bool) {
+		pending := &mergeHeap[T]{
+			compare: m.compare,
+		}
+		defer func() {
+			compareErr = pending.err
+		}()
+
+		for i, source := range sources {
+			next, stop := iter.Pull(source)
+			defer stop()
+
+			if current, ok := next(); ok {
+				pending.entries = append(pending.entries, mergeEntry[T]{
+					value:  current,
+					source: i,
+					next:   next,
+				})
+			}
+		}
+		if errs() != nil {
+			return
+		}
+
+		heap.Init(pending)
+
+		var last T
+		produced := false
+		for pending.Len() > 0 && pending.err == nil {
+			top := &pending.entries[0]
+
+			skip := false
+			if m.unique && produced {
+				var res int
+				if res, pending.err = m.compare(last, top.value); pending.err != nil {
+					return
+				}
+				skip = res == 0
+			}
+
+			if !skip {
+				if !yield(top.value) {
+					return
+				}
+				last, produced = top.value, true
+			}
+
+			if current, ok := top.next(); ok {
+				top.value = current
+				heap.Fix(pending, 0)
+			} else {
+				heap.Pop(pending)
+			}
+
+			if errs() != nil {
+				return
+			}
+		}
+	}, errs
+}
+
+type mergeEntry[T any] struct {
+	value  T
+	source int
+	next   func() (T, bool)
+}
+
+// mergeHeap holds the next value of each source which has not yet ended, with the least value at the top. Because
+// heap.Interface has no way to report errors, the first error returned by the comparator is recorded instead, and all
+// later comparisons are skipped.
+type mergeHeap[T any] struct {
+	entries []mergeEntry[T]
+	compare Comparator[T]
+	err     error
+}
+
+func (h *mergeHeap[T]) Len() int {
+	return len(h.entries)
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	if h.err != nil {
+		return false
+	}
+
+	res, err := h.compare(h.entries[i].value, h.entries[j].value)
+	if err != nil {
+		h.err = err
+		return false
+	}
+	if res != 0 {
+		return res < 0
+	}
+	return h.entries[i].source < h.entries[j].source
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+	h.entries = append(h.entries, x.(mergeEntry[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	last := len(h.entries) - 1
+	retval := h.entries[last]
+	h.entries = h.entries[:last]
+	return retval
+}
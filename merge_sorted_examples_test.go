@@ -0,0 +1,29 @@
+package collection_test
+
+import (
+	"fmt"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleMergeSorted() {
+	a := collection.NewLinkedList(1, 4, 7)
+	b := collection.NewLinkedList(2, 5, 8)
+	c := collection.NewLinkedList(3, 6, 9)
+
+	merged := collection.MergeSorted(collection.CompareOrdered[int], a, b, c)
+	fmt.Println(collection.ToSlice[int](merged))
+	// Output: [1 2 3 4 5 6 7 8 9]
+}
+
+func ExampleMergeSortedUnique() {
+	var fruits, colors collection.Dictionary
+	fruits.Add("apple")
+	fruits.Add("orange")
+	colors.Add("orange")
+	colors.Add("blue")
+
+	merged := collection.MergeSortedUnique(collection.CompareOrdered[string], fruits, colors)
+	fmt.Println(collection.ToSlice[string](merged))
+	// Output: [apple blue orange]
+}
@@ -0,0 +1,109 @@
+package collection
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMergeSorted(t *testing.T) {
+	testCases := []struct {
+		sources []Enumerable[int]
+		unique  bool
+		want    []int
+	}{
+		{nil, false, []int{}},
+		{[]Enumerable[int]{AsEnumerable(1, 4, 9)}, false, []int{1, 4, 9}},
+		{[]Enumerable[int]{AsEnumerable(1, 4, 9), AsEnumerable[int](), AsEnumerable(2, 3, 10)}, false, []int{1, 2, 3, 4, 9, 10}},
+		{[]Enumerable[int]{AsEnumerable(1, 2, 2), AsEnumerable(2, 3)}, false, []int{1, 2, 2, 2, 3}},
+		{[]Enumerable[int]{AsEnumerable(1, 2, 2), AsEnumerable(2, 3)}, true, []int{1, 2, 3}},
+	}
+
+	for _, tc := range testCases {
+		var subject Enumerable[int]
+		if tc.unique {
+			subject = MergeSortedUnique(UncheckedComparatori, tc.sources...)
+		} else {
+			subject = MergeSorted(UncheckedComparatori, tc.sources...)
+		}
+
+		got, err := ToSliceE(subject)
+		if err != nil {
+			t.Error(err)
+		}
+		if len(got) != len(tc.want) {
+			t.Logf("got: %v\nwant: %v", got, tc.want)
+			t.Fail()
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Logf("got: %v\nwant: %v", got, tc.want)
+				t.Fail()
+				break
+			}
+		}
+	}
+}
+
+func TestMergeSorted_Stable(t *testing.T) {
+	byFirst := func(a, b Pair[int, string]) (int, error) {
+		return a.First - b.First, nil
+	}
+
+	got := ToSlice(MergeSorted(byFirst,
+		AsEnumerable(Pair[int, string]{1, "a"}, Pair[int, string]{2, "a"}),
+		AsEnumerable(Pair[int, string]{1, "b"}, Pair[int, string]{2, "b"}),
+	))
+	want := []string{"a", "b", "a", "b"}
+
+	for i := range want {
+		if got[i].Second != want[i] {
+			t.Logf("got: %v\nwant: %v", got, want)
+			t.FailNow()
+		}
+	}
+}
+
+func TestMergeSorted_Infinite(t *testing.T) {
+	evens := RangeStep[uint](0, 2, ^uint(0))
+	got := ToSlice(Take(MergeSorted(CompareOrdered[uint], evens, Fibonacci), 8))
+	want := []uint{0, 0, 1, 1, 2, 2, 3, 4}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Logf("got: %v\nwant: %v", got, want)
+			t.FailNow()
+		}
+	}
+}
+
+func TestMergeSorted_ComparatorError(t *testing.T) {
+	errBroken := errors.New("broken")
+	comparator := func(a, b int) (int, error) {
+		if a == 3 || b == 3 {
+			return 0, errBroken
+		}
+		return a - b, nil
+	}
+
+	_, err := ToSliceE(MergeSorted(comparator, AsEnumerable(1, 2, 3), AsEnumerable(4)))
+	if !errors.Is(err, errBroken) {
+		t.Logf("got: %v\nwant: %v", err, errBroken)
+		t.Fail()
+	}
+}
+
+func TestMergeSorted_SourceError(t *testing.T) {
+	errBroken := errors.New("broken")
+	broken := FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) {
+			yield(0, errBroken)
+		}
+	})
+
+	_, err := ToSliceE(MergeSorted(UncheckedComparatori, broken, AsEnumerable(2, 3)))
+	if !errors.Is(err, errBroken) {
+		t.Logf("got: %v\nwant: %v", err, errBroken)
+		t.Fail()
+	}
+}
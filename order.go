@@ -0,0 +1,143 @@
+package collection
+
+import (
+	"cmp"
+	"context"
+	"iter"
+	"slices"
+)
+
+// OrderedEnumerable is an Enumerable whose values will be sorted when it is enumerated. Further criteria, used to
+// break ties between values which compare as equal, may be added using ThenBy and ThenByDescending.
+type OrderedEnumerable[T any] interface {
+	EnumerableE[T]
+
+	comparator() Comparator[T]
+	source() Enumerable[T]
+}
+
+type orderer[T any] struct {
+	original Enumerable[T]
+	compare  Comparator[T]
+}
+
+// CompareOrdered is a Comparator for any type which supports the `<` operator.
+func CompareOrdered[T cmp.Ordered](a, b T) (int, error) {
+	return cmp.Compare(a, b), nil
+}
+
+// OrderBy creates a reusable stream which will sort the values of an Enumerable in ascending order of the key selected
+// from each of them. The sort is stable, so values with equal keys keep the order they were encountered in.
+//
+// Enumerating the result requires reading every value of subject before the first one is produced. If comparator
+// returns an error, the enumeration produces no values, and the error is reported by EnumerateE.
+func OrderBy[T any, K any](subject Enumerable[T], keySelector Transform[T, K], comparator Comparator[K]) OrderedEnumerable[T] {
+	return orderer[T]{
+		original: subject,
+		compare:  byKey(keySelector, comparator),
+	}
+}
+
+// OrderByDescending creates a reusable stream which will sort the values of an Enumerable in descending order of the
+// key selected from each of them. It otherwise behaves like OrderBy.
+func OrderByDescending[T any, K any](subject Enumerable[T], keySelector Transform[T, K], comparator Comparator[K]) OrderedEnumerable[T] {
+	return orderer[T]{
+		original: subject,
+		compare:  descending(byKey(keySelector, comparator)),
+	}
+}
+
+// ThenBy adds a further criteria to an OrderedEnumerable, which sorts values that were otherwise equal in ascending
+// order of the key selected from each of them.
+func ThenBy[T any, K any](subject OrderedEnumerable[T], keySelector Transform[T, K], comparator Comparator[K]) OrderedEnumerable[T] {
+	return orderer[T]{
+		original: subject.source(),
+		compare:  thenBy(subject.comparator(), byKey(keySelector, comparator)),
+	}
+}
+
+// ThenByDescending adds a further criteria to an OrderedEnumerable, which sorts values that were otherwise equal in
+// descending order of the key selected from each of them.
+func ThenByDescending[T any, K any](subject OrderedEnumerable[T], keySelector Transform[T, K], comparator Comparator[K]) OrderedEnumerable[T] {
+	return orderer[T]{
+		original: subject.source(),
+		compare:  thenBy(subject.comparator(), descending(byKey(keySelector, comparator))),
+	}
+}
+
+func (o orderer[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, o)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early, including errors
+// returned by the Comparators used to sort.
+func (o orderer[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, o)
+}
+
+func (o orderer[T]) comparator() Comparator[T] {
+	return o.compare
+}
+
+func (o orderer[T]) source() Enumerable[T] {
+	return o.original
+}
+
+func (o orderer[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	original, originalErr := asSequence(ctx, o.original)
+	var err error
+
+	return func(yield func(T) bool) {
+		sorted := make([]T, 0)
+		for entry := range original {
+			sorted = append(sorted, entry)
+		}
+		if err = originalErr(); err != nil {
+			return
+		}
+
+		slices.SortStableFunc(sorted, func(a, b T) int {
+			if err != nil {
+				return 0
+			}
+			var res int
+			res, err = o.compare(a, b)
+			return res
+		})
+		if err != nil {
+			return
+		}
+
+		for _, entry := range sorted {
+			if !yield(entry) {
+				return
+			}
+		}
+	}, func() error { return err }
+}
+
+// byKey creates a Comparator which compares values by the keys selected from them.
+func byKey[T any, K any](keySelector Transform[T, K], comparator Comparator[K]) Comparator[T] {
+	return func(a, b T) (int, error) {
+		return comparator(keySelector(a), keySelector(b))
+	}
+}
+
+// descending creates a Comparator which reverses the order of another.
+func descending[T any](comparator Comparator[T]) Comparator[T] {
+	return func(a, b T) (int, error) {
+		res, err := comparator(a, b)
+		return -res, err
+	}
+}
+
+// thenBy creates a Comparator which consults `tieBreaker` only when `primary` finds two values equal.
+func thenBy[T any](primary, tieBreaker Comparator[T]) Comparator[T] {
+	return func(a, b T) (int, error) {
+		res, err := primary(a, b)
+		if err != nil || res != 0 {
+			return res, err
+		}
+		return tieBreaker(a, b)
+	}
+}
@@ -0,0 +1,46 @@
+package collection_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleOrderBy() {
+	subject := collection.NewList("charlie", "alfa", "delta", "bravo")
+	sorted := collection.OrderBy[string](subject, collection.Identity[string](), collection.CompareOrdered[string])
+	fmt.Println(collection.ToSlice[string](sorted))
+	// Output: [alfa bravo charlie delta]
+}
+
+func ExampleOrderByDescending() {
+	subject := collection.AsEnumerable(3, 1, 4, 1, 5, 9, 2, 6)
+	sorted := collection.OrderByDescending(subject, collection.Identity[int](), collection.CompareOrdered[int])
+	fmt.Println(collection.ToSlice[int](sorted))
+	// Output: [9 6 5 4 3 2 1 1]
+}
+
+func ExampleThenBy() {
+	subject := collection.AsEnumerable("pear", "fig", "apple", "kiwi", "date", "plum")
+
+	sorted := collection.OrderBy(subject, func(fruit string) int {
+		return len(fruit)
+	}, collection.CompareOrdered[int])
+	sorted = collection.ThenBy(sorted, strings.ToUpper, collection.CompareOrdered[string])
+
+	fmt.Println(collection.ToSlice[string](sorted))
+	// Output: [fig date kiwi pear plum apple]
+}
+
+func ExampleThenByDescending() {
+	subject := collection.AsEnumerable("pear", "fig", "apple", "kiwi", "date", "plum")
+
+	sorted := collection.OrderBy(subject, func(fruit string) int {
+		return len(fruit)
+	}, collection.CompareOrdered[int])
+	sorted = collection.ThenByDescending(sorted, collection.Identity[string](), collection.CompareOrdered[string])
+
+	fmt.Println(collection.ToSlice[string](sorted))
+	// Output: [fig plum pear kiwi date apple]
+}
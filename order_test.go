@@ -0,0 +1,67 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestOrderBy_Stable(t *testing.T) {
+	type pair struct {
+		Key   int
+		Order int
+	}
+
+	subject := AsEnumerable(
+		pair{2, 0},
+		pair{1, 1},
+		pair{2, 2},
+		pair{1, 3},
+		pair{0, 4},
+		pair{2, 5},
+	)
+
+	getKey := func(p pair) int {
+		return p.Key
+	}
+
+	want := "[{0 4} {1 1} {1 3} {2 0} {2 2} {2 5}]"
+	if got := fmt.Sprint(ToSlice[pair](OrderBy(subject, getKey, CompareOrdered[int]))); got != want {
+		t.Logf("got: %s\nwant: %s", got, want)
+		t.Fail()
+	}
+
+	want = "[{2 0} {2 2} {2 5} {1 1} {1 3} {0 4}]"
+	if got := fmt.Sprint(ToSlice[pair](OrderByDescending(subject, getKey, CompareOrdered[int]))); got != want {
+		t.Logf("got: %s\nwant: %s", got, want)
+		t.Fail()
+	}
+}
+
+func TestOrderBy_ComparatorError(t *testing.T) {
+	errIncomparable := errors.New("incomparable")
+
+	subject := AsEnumerable(3, 2, 1)
+	sorted := OrderBy(subject, Identity[int](), func(a, b int) (int, error) {
+		if a == 1 || b == 1 {
+			return 0, errIncomparable
+		}
+		return UncheckedComparatori(a, b)
+	})
+
+	got, err := ToSliceE[int](sorted)
+	if !errors.Is(err, errIncomparable) {
+		t.Logf("got: %v\nwant: %v", err, errIncomparable)
+		t.Fail()
+	}
+
+	if len(got) != 0 {
+		t.Logf("no values should have been produced, got: %v", got)
+		t.Fail()
+	}
+
+	if got, err := ToSliceE[int](Take[int](sorted, 1)); !errors.Is(err, errIncomparable) {
+		t.Logf("got: %v %v\nwant: %v", got, err, errIncomparable)
+		t.Fail()
+	}
+}
@@ -0,0 +1,373 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"runtime"
+	"runtime/debug"
+	"sync"
+)
+
+// ParallelOptions configures how the Parallel family of functions spread work across goroutines. The zero value uses
+// one worker per logical CPU, and produces results in whatever order they are finished.
+type ParallelOptions struct {
+	// Workers is the maximum number of goroutines which will run the operation at once. When zero, the number of logical
+	// CPUs available to the current process is used.
+	Workers uint
+
+	// Ordered causes results to be produced in the same order as the values they were computed from. Otherwise, each
+	// result is produced as soon as it is ready.
+	Ordered bool
+
+	// ReorderBuffer bounds the number of values which may be in progress or waiting on an earlier, slower, value to
+	// finish when Ordered is set. When zero, twice the number of Workers is used. It is never less than Workers.
+	ReorderBuffer uint
+}
+
+func (options ParallelOptions) workers() uint {
+	if options.Workers == 0 {
+		return uint(runtime.NumCPU())
+	}
+	return options.Workers
+}
+
+func (options ParallelOptions) reorderBuffer() uint {
+	workers := options.workers()
+	if options.ReorderBuffer == 0 {
+		return 2 * workers
+	}
+	return max(options.ReorderBuffer, workers)
+}
+
+// PanicError is reported when an operation being run by one of the Parallel functions panics.
+type PanicError struct {
+	// Value is the value that was passed to panic.
+	Value any
+
+	// Stack is the stack trace of the goroutine which panicked, at the moment it panicked.
+	Stack []byte
+}
+
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("operation panicked: %v", err.Value)
+}
+
+// Unwrap exposes the value that was passed to panic, if it was an error.
+func (err *PanicError) Unwrap() error {
+	if cast, ok := err.Value.(error); ok {
+		return cast
+	}
+	return nil
+}
+
+type parallelSelecter[T any, E any] struct {
+	original  Enumerable[T]
+	operation func(context.Context, T) (E, error)
+	options   ParallelOptions
+}
+
+// ParallelSelect creates an Enumerable which will use all logically available CPUs to
+// execute a Transform. Results are produced in the order they are finished.
+//
+// A panic in the Transform ends the enumeration, and is reported by EnumerateE as a *PanicError.
+func ParallelSelect[T any, E any](original Enumerable[T], operation Transform[T, E]) Enumerable[E] {
+	return ParallelSelectE(original, func(_ context.Context, value T) (E, error) {
+		return operation(value), nil
+	}, ParallelOptions{})
+}
+
+// ParallelSelectE creates an Enumerable which transforms each value using a pool of goroutines, as configured by
+// `options`.
+//
+// The context given to `operation` is cancelled as soon as any call to it returns an error or panics, or the
+// enumeration is otherwise stopped. The first such error ends the enumeration, and is reported by EnumerateE. Panics
+// are reported as a *PanicError.
+func ParallelSelectE[T any, E any](original Enumerable[T], operation func(context.Context, T) (E, error), options ParallelOptions) EnumerableE[E] {
+	return parallelSelecter[T, E]{
+		original:  original,
+		operation: operation,
+		options:   options,
+	}
+}
+
+func (ps parallelSelecter[T, E]) Enumerate(ctx context.Context) Enumerator[E] {
+	return enumerateSequence[E](ctx, ps)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early, including errors
+// returned by the operation.
+func (ps parallelSelecter[T, E]) EnumerateE(ctx context.Context) (Enumerator[E], func() error) {
+	return enumerateSequenceE[E](ctx, ps)
+}
+
+func (ps parallelSelecter[T, E]) sequence(ctx context.Context) (iter.Seq[E], func() error) {
+	return parallelize(ctx, ps.original, ps.operation, ps.options)
+}
+
+type parallelJob[T any] struct {
+	index uint64
+	value T
+}
+
+// parallelize runs an operation on each value of an Enumerable using a pool of workers, and yields the results on the
+// caller's goroutine. All workers have stopped by the time the returned iterator returns.
+func parallelize[T any, E any](ctx context.Context, subject Enumerable[T], operation func(context.Context, T) (E, error), options ParallelOptions) (iter.Seq[E], func() error) {
+	var err error
+
+	return func(yield func(E) bool) {
+		workCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var failure error
+		var failOnce sync.Once
+		fail := func(cause error) {
+			failOnce.Do(func() {
+				failure = cause
+				cancel()
+			})
+		}
+
+		// In order to preserve order, a slot must be claimed before a value is dispatched, and it isn't released until
+		// the result has been yielded. This bounds how far ahead of the slowest value the workers may get.
+		var slots chan struct{}
+		if options.Ordered {
+			slots = make(chan struct{}, options.reorderBuffer())
+		}
+
+		jobs := make(chan parallelJob[T])
+		dispatched := make(chan struct{})
+		go func() {
+			defer close(dispatched)
+			defer close(jobs)
+
+			source, sourceErr := asSequence(workCtx, subject)
+			var i uint64
+			for entry := range source {
+				if slots != nil {
+					select {
+					case slots <- struct{}{}:
+						// Intentionally Left Blank
+					case <-workCtx.Done():
+						return
+					}
+				}
+
+				select {
+				case jobs <- parallelJob[T]{index: i, value: entry}:
+					i++
+				case <-workCtx.Done():
+					return
+				}
+			}
+
+			if cause := sourceErr(); cause != nil && workCtx.Err() == nil {
+				fail(cause)
+			}
+		}()
+
+		results := make(chan parallelJob[E])
+		var wg sync.WaitGroup
+		for w := uint(0); w < options.workers(); w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for job := range jobs {
+					result, cause := protect(workCtx, operation, job.value)
+					if cause != nil {
+						fail(cause)
+						return
+					}
+
+					select {
+					case results <- parallelJob[E]{index: job.index, value: result}:
+						// Intentionally Left Blank
+					case <-workCtx.Done():
+						return
+					}
+				}
+			}()
+		}
+
+		go func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		stopped := false
+		defer func() {
+			cancel()
+			for range results {
+				// Intentionally Left Blank
+			}
+			<-dispatched
+
+			if !stopped {
+				err = failure
+				if err == nil {
+					err = ctx.Err()
+				}
+			}
+		}()
+
+		pending := make(map[uint64]E)
+		var next uint64
+		for result := range results {
+			if !options.Ordered {
+				if !yield(result.value) {
+					stopped = true
+					return
+				}
+				continue
+			}
+
+			pending[result.index] = result.value
+			for {
+				current, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				next++
+				<-slots
+
+				if !yield(current) {
+					stopped = true
+					return
+				}
+			}
+		}
+	}, func() error { return err }
+}
+
+// protect runs an operation, converting a panic into a *PanicError.
+func protect[T any, E any](ctx context.Context, operation func(context.Context, T) (E, error), value T) (result E, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = &PanicError{
+				Value: recovered,
+				Stack: debug.Stack(),
+			}
+		}
+	}()
+
+	return operation(ctx, value)
+}
+
+type parallelWherer[T any] struct {
+	original Enumerable[T]
+	filter   func(context.Context, T) (bool, error)
+	options  ParallelOptions
+}
+
+// ParallelWhere creates an Enumerable which will use all logically available CPUs to evaluate a Predicate. Values which
+// satisfy the Predicate are produced in the order they are finished.
+//
+// A panic in the Predicate ends the enumeration, and is reported by EnumerateE as a *PanicError.
+func ParallelWhere[T any](original Enumerable[T], p Predicate[T]) Enumerable[T] {
+	return ParallelWhereE(original, func(_ context.Context, value T) (bool, error) {
+		return p(value), nil
+	}, ParallelOptions{})
+}
+
+// ParallelWhereE creates an Enumerable which evaluates a filter against each value using a pool of goroutines, as
+// configured by `options`, and produces only the values which satisfy it. Errors and panics are handled as they are by
+// ParallelSelectE.
+func ParallelWhereE[T any](original Enumerable[T], filter func(context.Context, T) (bool, error), options ParallelOptions) EnumerableE[T] {
+	return parallelWherer[T]{
+		original: original,
+		filter:   filter,
+		options:  options,
+	}
+}
+
+func (pw parallelWherer[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, pw)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early, including errors
+// returned by the filter.
+func (pw parallelWherer[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, pw)
+}
+
+func (pw parallelWherer[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	filtered, err := parallelize(ctx, pw.original, func(ctx context.Context, value T) (Pair[T, bool], error) {
+		keep, err := pw.filter(ctx, value)
+		return Pair[T, bool]{First: value, Second: keep}, err
+	}, pw.options)
+
+	return func(yield func(T) bool) {
+		for entry := range filtered {
+			if entry.Second && !yield(entry.First) {
+				return
+			}
+		}
+	}, err
+}
+
+type parallelSelectManyer[T any, E any] struct {
+	original Enumerable[T]
+	toMany   func(context.Context, T) ([]E, error)
+	options  ParallelOptions
+}
+
+// ParallelSelectMany creates an Enumerable which will use all logically available CPUs to unfold values. All of the
+// values unfolded from a single parent are produced together, but parents are handled in the order they are finished.
+//
+// A panic in the Unfolder ends the enumeration, and is reported by EnumerateE as a *PanicError.
+func ParallelSelectMany[T any, E any](original Enumerable[T], toMany Unfolder[T, E]) Enumerable[E] {
+	return ParallelSelectManyE(original, func(_ context.Context, value T) ([]E, error) {
+		return toMany(value).ToSlice(), nil
+	}, ParallelOptions{})
+}
+
+// ParallelSelectManyE creates an Enumerable which unfolds each value into many using a pool of goroutines, as
+// configured by `options`. Errors and panics are handled as they are by ParallelSelectE.
+func ParallelSelectManyE[T any, E any](original Enumerable[T], toMany func(context.Context, T) ([]E, error), options ParallelOptions) EnumerableE[E] {
+	return parallelSelectManyer[T, E]{
+		original: original,
+		toMany:   toMany,
+		options:  options,
+	}
+}
+
+func (psm parallelSelectManyer[T, E]) Enumerate(ctx context.Context) Enumerator[E] {
+	return enumerateSequence[E](ctx, psm)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early, including errors
+// returned while unfolding.
+func (psm parallelSelectManyer[T, E]) EnumerateE(ctx context.Context) (Enumerator[E], func() error) {
+	return enumerateSequenceE[E](ctx, psm)
+}
+
+func (psm parallelSelectManyer[T, E]) sequence(ctx context.Context) (iter.Seq[E], func() error) {
+	unfolded, err := parallelize(ctx, psm.original, psm.toMany, psm.options)
+	return func(yield func(E) bool) {
+		for children := range unfolded {
+			for _, child := range children {
+				if !yield(child) {
+					return
+				}
+			}
+		}
+	}, err
+}
+
+// ParallelForEach runs an action on each value of an Enumerable using up to `workers` goroutines at once. When workers
+// is zero, the number of logical CPUs available to the current process is used.
+//
+// The context given to `action` is cancelled as soon as any call to it returns an error or panics, or ctx is
+// cancelled. ParallelForEach waits for all running actions to return, then reports the first error encountered, if
+// any. Panics are reported as a *PanicError.
+func ParallelForEach[T any](ctx context.Context, subject Enumerable[T], workers uint, action func(context.Context, T) error) error {
+	done, err := parallelize(ctx, subject, func(ctx context.Context, value T) (struct{}, error) {
+		return struct{}{}, action(ctx, value)
+	}, ParallelOptions{Workers: workers})
+
+	for range done {
+		// Intentionally Left Blank
+	}
+	return err()
+}
@@ -0,0 +1,51 @@
+package collection_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleParallelSelect() {
+	subject := collection.AsEnumerable(1, 2, 3, 4, 5)
+	squares := collection.ParallelSelect(subject, func(x int) int {
+		return x * x
+	})
+	fmt.Println(collection.Sum(squares))
+	// Output: 55 <nil>
+}
+
+func ExampleParallelSelectE() {
+	subject := collection.AsEnumerable("1", "2", "three", "4")
+	parsed := collection.ParallelSelectE(subject, func(ctx context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	}, collection.ParallelOptions{
+		Workers: 2,
+		Ordered: true,
+	})
+
+	_, err := collection.ToSliceE[int](parsed)
+	fmt.Println(err)
+	// Output: strconv.Atoi: parsing "three": invalid syntax
+}
+
+func ExampleParallelWhere() {
+	subject := collection.AsEnumerable(1, 2, 3, 4, 5, 6)
+	evens := collection.ParallelWhere(subject, func(x int) bool {
+		return x%2 == 0
+	})
+	fmt.Println(collection.Sum(evens))
+	// Output: 12 <nil>
+}
+
+func ExampleParallelForEach() {
+	subject := collection.AsEnumerable("1", "2", "three", "4")
+	err := collection.ParallelForEach(context.Background(), subject, 2, func(ctx context.Context, s string) error {
+		_, err := strconv.Atoi(s)
+		return err
+	})
+	fmt.Println(err)
+	// Output: strconv.Atoi: parsing "three": invalid syntax
+}
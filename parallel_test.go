@@ -0,0 +1,202 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelSelectE_Ordered(t *testing.T) {
+	subject := EnumerableSlice[int](getInitializedSequentialArray[int]())
+
+	var running, highWater int32
+	results := ParallelSelectE[int, int](subject, func(ctx context.Context, x int) (int, error) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			seen := atomic.LoadInt32(&highWater)
+			if current <= seen || atomic.CompareAndSwapInt32(&highWater, seen, current) {
+				break
+			}
+		}
+
+		if x%7 == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		return x * 2, nil
+	}, ParallelOptions{
+		Workers:       3,
+		Ordered:       true,
+		ReorderBuffer: 5,
+	})
+
+	got, err := ToSliceE[int](results)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(got) != len(subject) {
+		t.Logf("got: %d results\nwant: %d results", len(got), len(subject))
+		t.FailNow()
+	}
+	for i := range got {
+		if want := subject[i] * 2; got[i] != want {
+			t.Logf("position %d\ngot: %d\nwant: %d", i, got[i], want)
+			t.FailNow()
+		}
+	}
+
+	if highWater > 3 {
+		t.Logf("at most 3 workers should have run at once, saw %d", highWater)
+		t.Fail()
+	}
+}
+
+func TestParallelSelectE_FirstErrorStopsWorkers(t *testing.T) {
+	errBroken := errors.New("broken")
+	var calls int32
+
+	results := ParallelSelectE[uint, uint](Fibonacci, func(ctx context.Context, x uint) (uint, error) {
+		if atomic.AddInt32(&calls, 1) == 10 {
+			return 0, errBroken
+		}
+		return x, nil
+	}, ParallelOptions{Workers: 4})
+
+	_, err := ToSliceE[uint](results)
+	if !errors.Is(err, errBroken) {
+		t.Logf("got: %v\nwant: %v", err, errBroken)
+		t.Fail()
+	}
+}
+
+func TestParallelSelect_Panic(t *testing.T) {
+	results := ParallelSelect(AsEnumerable(1, 2, 0, 4), func(x int) int {
+		return 12 / x
+	})
+
+	_, err := ToSliceE(results)
+
+	var panicked *PanicError
+	if !errors.As(err, &panicked) {
+		t.Logf("got: %v\nwant: a *PanicError", err)
+		t.FailNow()
+	}
+
+	if len(panicked.Stack) == 0 {
+		t.Log("the stack trace should have been captured")
+		t.Fail()
+	}
+}
+
+func TestParallelSelect_StopEarly(t *testing.T) {
+	results := ParallelSelect(Fibonacci, Identity[uint]())
+	if got := len(ToSlice(Take(results, 5))); got != 5 {
+		t.Logf("got: %d\nwant: %d", got, 5)
+		t.Fail()
+	}
+}
+
+func TestParallelWhereE_Ordered(t *testing.T) {
+	subject := EnumerableSlice[int](getInitializedSequentialArray[int]())
+
+	results := ParallelWhereE[int](subject, func(ctx context.Context, x int) (bool, error) {
+		return x%3 == 0, nil
+	}, ParallelOptions{
+		Workers: 4,
+		Ordered: true,
+	})
+
+	got, err := ToSliceE[int](results)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := Where[int](subject, func(x int) bool { return x%3 == 0 }).Enumerate(context.Background()).ToSlice()
+	if len(got) != len(want) {
+		t.Logf("got: %v\nwant: %v", got, want)
+		t.FailNow()
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Logf("got: %v\nwant: %v", got, want)
+			t.FailNow()
+		}
+	}
+}
+
+func TestParallelSelectMany(t *testing.T) {
+	subject := AsEnumerable(1, 2, 3, 4)
+	results := ParallelSelectMany(subject, func(x int) Enumerator[int] {
+		children := make(EnumerableSlice[int], x)
+		for i := range children {
+			children[i] = x
+		}
+		return children.Enumerate(context.Background())
+	})
+
+	counts := make(map[int]int)
+	for entry := range results.Enumerate(context.Background()) {
+		counts[entry]++
+	}
+
+	for i := 1; i <= 4; i++ {
+		if counts[i] != i {
+			t.Logf("value %d\ngot: %d occurrences\nwant: %d occurrences", i, counts[i], i)
+			t.Fail()
+		}
+	}
+}
+
+func TestParallelForEach(t *testing.T) {
+	var total int64
+	err := ParallelForEach(context.Background(), AsEnumerable[int64](1, 2, 3, 4, 5), 2, func(ctx context.Context, x int64) error {
+		atomic.AddInt64(&total, x)
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if total != 15 {
+		t.Logf("got: %d\nwant: %d", total, 15)
+		t.Fail()
+	}
+}
+
+func TestParallelForEach_FirstError(t *testing.T) {
+	errBroken := errors.New("broken")
+	var calls int32
+
+	err := ParallelForEach(context.Background(), Fibonacci, 4, func(ctx context.Context, x uint) error {
+		if atomic.AddInt32(&calls, 1) == 10 {
+			return errBroken
+		}
+		return nil
+	})
+	if !errors.Is(err, errBroken) {
+		t.Logf("got: %v\nwant: %v", err, errBroken)
+		t.Fail()
+	}
+}
+
+func TestParallelForEach_Panic(t *testing.T) {
+	err := ParallelForEach(context.Background(), AsEnumerable(1, 2, 3), 0, func(ctx context.Context, x int) error {
+		if x == 2 {
+			panic("two")
+		}
+		return nil
+	})
+
+	var cast *PanicError
+	if !errors.As(err, &cast) {
+		t.Logf("got: %v\nwant: a *PanicError", err)
+		t.FailNow()
+	}
+	if cast.Value != "two" {
+		t.Logf("got: %v\nwant: %v", cast.Value, "two")
+		t.Fail()
+	}
+}
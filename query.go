@@ -3,7 +3,7 @@ package collection
 import (
 	"context"
 	"errors"
-	"runtime"
+	"iter"
 	"sync"
 )
 
@@ -13,6 +13,16 @@ type Enumerable[T any] interface {
 	Enumerate(ctx context.Context) Enumerator[T]
 }
 
+// EnumerableE is an Enumerable which is able to report the error, if any, that caused its enumeration to end before all
+// of its values were produced. This allows a consumer to tell a truncated stream from a complete one.
+type EnumerableE[T any] interface {
+	Enumerable[T]
+
+	// EnumerateE behaves like Enumerate, but also returns a function reporting why the enumeration ended. That function
+	// must not be called until the returned Enumerator has been closed.
+	EnumerateE(ctx context.Context) (Enumerator[T], func() error)
+}
+
 // Enumerator exposes a new syntax for querying familiar data structures.
 type Enumerator[T any] <-chan T
 
@@ -27,6 +37,11 @@ type Unfolder[T any, E any] func(T) Enumerator[E]
 
 type emptyEnumerable[T any] struct{}
 
+// A collection of errors that may be thrown by functions in this file.
+var (
+	ErrPartitionConsumed = errors.New("partition half was enumerated after its values were no longer available")
+)
+
 var (
 	errNoElements       = errors.New("enumerator encountered no elements")
 	errMultipleElements = errors.New("enumerator encountered multiple elements")
@@ -61,6 +76,14 @@ func (e emptyEnumerable[T]) Enumerate(ctx context.Context) Enumerator[T] {
 	return results
 }
 
+func (e emptyEnumerable[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return e.Enumerate(ctx), noError
+}
+
+func (e emptyEnumerable[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	return func(yield func(T) bool) {}, noError
+}
+
 // All tests whether or not all items present in an Enumerable meet a criteria.
 func All[T any](subject Enumerable[T], p Predicate[T]) bool {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -105,6 +128,17 @@ func Anyp[T any](iterator Enumerable[T], p Predicate[T]) bool {
 
 type EnumerableSlice[T any] []T
 
+// All returns an iterator over each index and value in the slice.
+func (f EnumerableSlice[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, entry := range f {
+			if !yield(i, entry) {
+				return
+			}
+		}
+	}
+}
+
 func (f EnumerableSlice[T]) Enumerate(ctx context.Context) Enumerator[T] {
 	results := make(chan T)
 
@@ -123,12 +157,24 @@ func (f EnumerableSlice[T]) Enumerate(ctx context.Context) Enumerator[T] {
 	return results
 }
 
+// Seq returns an iterator over each value in the slice.
+func (f EnumerableSlice[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, entry := range f {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
 // AsEnumerable allows for easy conversion of a slice to a re-usable Enumerable object.
 func AsEnumerable[T any](entries ...T) Enumerable[T] {
 	return EnumerableSlice[T](entries)
 }
 
-// AsEnumerable stores the results of an Enumerator so the results can be enumerated over repeatedly.
+// AsEnumerable stores the results of an Enumerator so the results can be enumerated over repeatedly. It reads every
+// value before returning, so it never returns for an Enumerator which never ends; use Memoize in that case.
 func (iter Enumerator[T]) AsEnumerable() Enumerable[T] {
 	return EnumerableSlice[T](iter.ToSlice())
 }
@@ -172,12 +218,18 @@ func (iter Enumerator[T]) Discard() {
 	}
 }
 
-// ElementAt retreives an item at a particular position in an Enumerator.
+// ElementAt retreives an item at a particular position in an Enumerable. Reading stops as soon as the item is found.
 func ElementAt[T any](iter Enumerable[T], n uint) T {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	cursor := Open(iter)
+	defer cursor.Close()
 
-	return iter.Enumerate(ctx).ElementAt(n)
+	for i := uint(0); i < n; i++ {
+		if _, ok := cursor.Next(); !ok {
+			return *new(T)
+		}
+	}
+	retval, _ := cursor.Next()
+	return retval
 }
 
 // ElementAt retreives an item at a particular position in an Enumerator.
@@ -188,21 +240,139 @@ func (iter Enumerator[T]) ElementAt(n uint) T {
 	return <-iter
 }
 
-// First retrieves just the first item in the list, or returns an error if there are no elements in the array.
+// ElementAtE retreives an item at a particular position in an Enumerable. If there are not enough items, an error is
+// returned which can be identified with IsErrorNoElements. Reading stops as soon as the item is found.
+func ElementAtE[T any](iter Enumerable[T], n uint) (T, error) {
+	cursor := Open(iter)
+	defer cursor.Close()
+
+	for i := uint(0); ; i++ {
+		current, ok := cursor.Next()
+		if !ok {
+			if err := cursor.Err(); err != nil {
+				return *new(T), err
+			}
+			return *new(T), errNoElements
+		}
+		if i == n {
+			return current, nil
+		}
+	}
+}
+
+// ElementAtE retreives an item at a particular position in an Enumerator. If there are not enough items, an error is
+// returned which can be identified with IsErrorNoElements. Any items after the one found are left unread.
+func (iter Enumerator[T]) ElementAtE(n uint) (T, error) {
+	for i := uint(0); i < n; i++ {
+		if _, ok := <-iter; !ok {
+			return *new(T), errNoElements
+		}
+	}
+
+	if retval, ok := <-iter; ok {
+		return retval, nil
+	}
+	return *new(T), errNoElements
+}
+
+// ElementAtOrDefault retreives an item at a particular position in an Enumerable, or `defaultValue` if there are not
+// enough items.
+func ElementAtOrDefault[T any](iter Enumerable[T], n uint, defaultValue T) T {
+	if retval, err := ElementAtE(iter, n); err == nil {
+		return retval
+	}
+	return defaultValue
+}
+
+// ElementAtOrDefault retreives an item at a particular position in an Enumerator, or `defaultValue` if there are not
+// enough items. Any items after the one found are left unread.
+func (iter Enumerator[T]) ElementAtOrDefault(n uint, defaultValue T) T {
+	if retval, err := iter.ElementAtE(n); err == nil {
+		return retval
+	}
+	return defaultValue
+}
+
+// EnumerateE begins enumerating an Enumerable, and returns a function which reports the error, if any, that caused the
+// enumeration to end early. Enumerables which implement EnumerableE report their own errors. For all others, the only
+// error which can be reported is the cancellation of ctx, if it had happened by the time the enumeration ended. The
+// returned function must not be called until the Enumerator has been closed.
+func EnumerateE[T any](ctx context.Context, subject Enumerable[T]) (Enumerator[T], func() error) {
+	if cast, ok := subject.(EnumerableE[T]); ok {
+		return cast.EnumerateE(ctx)
+	}
+
+	results := make(chan T)
+	var err error
+
+	go func() {
+		defer close(results)
+
+		for entry := range subject.Enumerate(ctx) {
+			select {
+			case results <- entry:
+				// Intentionally Left Blank
+			case <-ctx.Done():
+				err = ctx.Err()
+				return
+			}
+		}
+		err = ctx.Err()
+	}()
+
+	return results, func() error { return err }
+}
+
+// First retrieves just the first item in the list, or returns an error if there are no elements in the array. If the
+// list fails before producing an item, its error is returned instead.
 func First[T any](subject Enumerable[T]) (retval T, err error) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	cursor := Open(subject)
+	defer cursor.Close()
 
-	err = errNoElements
 	var isOpen bool
-
-	if retval, isOpen = <-subject.Enumerate(ctx); isOpen {
-		err = nil
+	if retval, isOpen = cursor.Next(); !isOpen {
+		if err = cursor.Err(); err == nil {
+			err = errNoElements
+		}
 	}
 
 	return
 }
 
+// FirstOrDefault retrieves just the first item in an Enumerable, or `defaultValue` if there are no items.
+func FirstOrDefault[T any](subject Enumerable[T], defaultValue T) T {
+	if retval, err := First(subject); err == nil {
+		return retval
+	}
+	return defaultValue
+}
+
+// FirstOrDefault retrieves just the first item in an Enumerator, or `defaultValue` if there are no items. Any items
+// after the first are left unread.
+func (iter Enumerator[T]) FirstOrDefault(defaultValue T) T {
+	if retval, ok := <-iter; ok {
+		return retval
+	}
+	return defaultValue
+}
+
+// Firstp retrieves the first item in an Enumerable which satisfies a Predicate, or returns an error if none do. Reading
+// stops as soon as a match is found.
+func Firstp[T any](subject Enumerable[T], p Predicate[T]) (T, error) {
+	return ElementAtE(Where(subject, p), 0)
+}
+
+// Firstp retrieves the first item in an Enumerator which satisfies a Predicate, or returns an error if none do. Any
+// items after the match are left unread.
+func (iter Enumerator[T]) Firstp(p Predicate[T]) (T, error) {
+	for entry := range iter {
+		if p(entry) {
+			return entry, nil
+		}
+	}
+	return *new(T), errNoElements
+}
+
 // Last retreives the item logically behind all other elements in the list.
 func Last[T any](iter Enumerable[T]) T {
 	return iter.Enumerate(context.Background()).Last()
@@ -216,6 +386,50 @@ func (iter Enumerator[T]) Last() (retval T) {
 	return
 }
 
+// LastOrDefault retreives the item logically behind all other elements in an Enumerable, or `defaultValue` if there
+// are no items.
+func LastOrDefault[T any](iter Enumerable[T], defaultValue T) T {
+	return iter.Enumerate(context.Background()).LastOrDefault(defaultValue)
+}
+
+// LastOrDefault retreives the item logically behind all other elements in an Enumerator, or `defaultValue` if there
+// are no items.
+func (iter Enumerator[T]) LastOrDefault(defaultValue T) T {
+	retval := defaultValue
+	for retval = range iter {
+		// Intentionally Left Blank
+	}
+	return retval
+}
+
+// Lastp retreives the last item in an Enumerable which satisfies a Predicate, or returns an error if none do.
+func Lastp[T any](iter Enumerable[T], p Predicate[T]) (retval T, err error) {
+	seq, seqErr := asSequence(context.Background(), iter)
+
+	err = errNoElements
+	for entry := range seq {
+		if p(entry) {
+			retval, err = entry, nil
+		}
+	}
+
+	if sourceErr := seqErr(); sourceErr != nil {
+		return *new(T), sourceErr
+	}
+	return
+}
+
+// Lastp retreives the last item in an Enumerator which satisfies a Predicate, or returns an error if none do.
+func (iter Enumerator[T]) Lastp(p Predicate[T]) (retval T, err error) {
+	err = errNoElements
+	for entry := range iter {
+		if p(entry) {
+			retval, err = entry, nil
+		}
+	}
+	return
+}
+
 type merger[T any] struct {
 	originals []Enumerable[T]
 }
@@ -276,42 +490,243 @@ func (iter Enumerator[T]) Merge(others ...Enumerator[T]) Enumerator[T] {
 	return retval
 }
 
-type parallelSelecter[T any, E any] struct {
-	original  Enumerable[T]
-	operation Transform[T, E]
+// Partition splits an Enumerable into the values which satisfy a Predicate, and those which don't. The Predicate is
+// evaluated only once per value, and both halves are fed by a single, shared, enumeration of `subject`, which begins
+// when either half is first enumerated. Each half may only be enumerated once.
+//
+// Values meant for a half which is not keeping up are buffered until it reads them, so the halves may be consumed in
+// any order without deadlocking. A half's buffer is released once it finishes. Once neither half is being enumerated,
+// the shared enumeration is stopped; a half which is enumerated after that point produces the values which were
+// buffered for it, then reports ErrPartitionConsumed.
+//
+// The source is read on its own goroutine, only while a half is waiting for a value. A half whose context is cancelled
+// stops waiting straight away, and the other half may keep reading its buffer while the source is slow.
+func Partition[T any](subject Enumerable[T], p Predicate[T]) (matched, unmatched Enumerable[T]) {
+	ctx, cancel := context.WithCancel(context.Background())
+	shared := &partition[T]{
+		subject: subject,
+		p:       p,
+		ctx:     ctx,
+		cancel:  cancel,
+		demand:  make(chan struct{}, 1),
+		changed: make(chan struct{}),
+	}
+	for i := range shared.halves {
+		shared.halves[i] = &partitionHalf[T]{
+			shared:  shared,
+			matched: i == 0,
+		}
+	}
+	return shared.halves[0], shared.halves[1]
+}
+
+type partition[T any] struct {
+	subject Enumerable[T]
+	p       Predicate[T]
+	ctx     context.Context
+	cancel  context.CancelFunc
+	halves  [2]*partitionHalf[T]
+	started sync.Once
+
+	// demand is signalled whenever a half begins waiting for a value, so that the goroutine reading the source knows to
+	// read another.
+	demand chan struct{}
+
+	// key guards every field below, as well as the state, buffer and waiting flag of each half. It is never held while
+	// a value is being read from the source.
+	key     sync.Mutex
+	changed chan struct{}
+	active  uint
+	ended   bool
+	err     error
+}
+
+// end marks the shared enumeration as having stopped, releases it, and wakes any halves waiting for more values. The
+// caller must hold the key.
+func (p *partition[T]) end(err error) {
+	if p.ended {
+		return
+	}
+	p.ended = true
+	p.err = err
+	p.cancel()
+	close(p.changed)
+}
+
+// pump reads values from the source, one at a time, as they are wanted by the halves, and routes each to the half it
+// belongs to.
+func (p *partition[T]) pump() {
+	source, sourceErr := asSequence(p.ctx, p.subject)
+	for entry := range source {
+		p.route(entry)
+		if !p.awaitDemand() {
+			break
+		}
+	}
+
+	p.key.Lock()
+	defer p.key.Unlock()
+	p.end(sourceErr())
 }
 
-func (ps parallelSelecter[T, E]) Enumerate(ctx context.Context) Enumerator[E] {
-	iter := ps.original.Enumerate(ctx)
-	if cpus := runtime.NumCPU(); cpus != 1 {
-		intermediate := splitN(iter, ps.operation, uint(cpus))
-		return intermediate[0].Merge(intermediate[1:]...)
-	}
+// awaitDemand waits until a half is waiting for a value which has not been read yet. It reports false if the shared
+// enumeration ends first.
+func (p *partition[T]) awaitDemand() bool {
+	for {
+		p.key.Lock()
+		wanted := false
+		for _, half := range p.halves {
+			wanted = wanted || (half.waiting && len(half.buffer) == 0)
+		}
+		p.key.Unlock()
+		if wanted {
+			return true
+		}
 
-	return Select(ps.original, ps.operation).Enumerate(ctx)
+		select {
+		case <-p.demand:
+			// Intentionally Left Blank
+		case <-p.ctx.Done():
+			return false
+		}
+	}
 }
 
-// ParallelSelect creates an Enumerable which will use all logically available CPUs to
-// execute a Transform.
-func ParallelSelect[T any, E any](original Enumerable[T], operation Transform[T, E]) Enumerable[E] {
-	return parallelSelecter[T, E]{
-		original:  original,
-		operation: operation,
+// route adds a value which has just been read from the source to the buffer of the half it belongs to, unless that
+// half has already finished, and wakes any halves waiting for it.
+func (p *partition[T]) route(entry T) {
+	half := p.halves[1]
+	if p.p(entry) {
+		half = p.halves[0]
+	}
+
+	p.key.Lock()
+	defer p.key.Unlock()
+
+	if p.ended {
+		return
 	}
+	if half.state != partitionFinished {
+		half.buffer = append(half.buffer, entry)
+	}
+
+	close(p.changed)
+	p.changed = make(chan struct{})
 }
 
-// ParallelSelect will execute a Transform across all logical CPUs available to the current process.
-//
-// This is commented out, because Go 1.18 adds support for generics, but disallows methods from having type parameters
-// not declared by their receivers.
-//
-//func (iter Enumerator[T]) ParallelSelect[E any](operation Transform[T, E]) Enumerator[E] {
-//	if cpus := runtime.NumCPU(); cpus != 1 {
-//		intermediate := iter.splitN(operation, uint(cpus))
-//		return intermediate[0].Merge(intermediate[1:]...)
-//	}
-//	return iter
-//}
+type partitionState uint
+
+const (
+	partitionUnclaimed partitionState = iota
+	partitionActive
+	partitionFinished
+)
+
+type partitionHalf[T any] struct {
+	shared  *partition[T]
+	matched bool
+	state   partitionState
+	buffer  []T
+	waiting bool
+}
+
+func (h *partitionHalf[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, h)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early, including the
+// source's errors and ErrPartitionConsumed.
+func (h *partitionHalf[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, h)
+}
+
+func (h *partitionHalf[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	var err error
+	return func(yield func(T) bool) {
+		shared := h.shared
+
+		shared.key.Lock()
+		if h.state != partitionUnclaimed {
+			shared.key.Unlock()
+			err = ErrPartitionConsumed
+			return
+		}
+		h.state = partitionActive
+		shared.active++
+		shared.key.Unlock()
+
+		defer func() {
+			shared.key.Lock()
+			defer shared.key.Unlock()
+
+			h.state = partitionFinished
+			h.buffer = nil
+			h.waiting = false
+			shared.active--
+			if shared.active == 0 {
+				shared.end(ErrPartitionConsumed)
+			}
+		}()
+
+		for {
+			if err = ctx.Err(); err != nil {
+				return
+			}
+
+			current, ok, cause := h.read(ctx)
+			if !ok {
+				err = cause
+				return
+			}
+
+			if !yield(current) {
+				return
+			}
+		}
+	}, func() error { return err }
+}
+
+// read finds the next value for this half, waiting for it to be read from the source if its buffer is empty. It
+// reports false if there are no more values for this half, or ctx was cancelled while waiting for one, along with the
+// reason why.
+func (h *partitionHalf[T]) read(ctx context.Context) (T, bool, error) {
+	shared := h.shared
+	for {
+		shared.key.Lock()
+		if len(h.buffer) > 0 {
+			current := h.buffer[0]
+			h.buffer[0] = *new(T)
+			h.buffer = h.buffer[1:]
+			h.waiting = false
+			shared.key.Unlock()
+			return current, true, nil
+		}
+		if shared.ended {
+			shared.key.Unlock()
+			return *new(T), false, shared.err
+		}
+		h.waiting = true
+		changed := shared.changed
+		shared.key.Unlock()
+
+		shared.started.Do(func() {
+			go shared.pump()
+		})
+		select {
+		case shared.demand <- struct{}{}:
+			// Intentionally Left Blank
+		default:
+			// Intentionally Left Blank
+		}
+
+		select {
+		case <-changed:
+			// Intentionally Left Blank
+		case <-ctx.Done():
+			return *new(T), false, ctx.Err()
+		}
+	}
+}
 
 type reverser[T any] struct {
 	original Enumerable[T]
@@ -325,7 +740,29 @@ func Reverse[T any](original Enumerable[T]) Enumerable[T] {
 }
 
 func (r reverser[T]) Enumerate(ctx context.Context) Enumerator[T] {
-	return r.original.Enumerate(ctx).Reverse()
+	return enumerateSequence[T](ctx, r)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (r reverser[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, r)
+}
+
+func (r reverser[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	original, err := asSequence(ctx, r.original)
+	return func(yield func(T) bool) {
+		cache := NewStack[T]()
+		for entry := range original {
+			cache.Push(entry)
+		}
+
+		for !cache.IsEmpty() {
+			val, _ := cache.Pop()
+			if !yield(val) {
+				return
+			}
+		}
+	}, err
 }
 
 // Reverse returns items in the opposite order it encountered them in.
@@ -353,22 +790,23 @@ type selecter[T any, E any] struct {
 }
 
 func (s selecter[T, E]) Enumerate(ctx context.Context) Enumerator[E] {
-	retval := make(chan E)
+	return enumerateSequence[E](ctx, s)
+}
 
-	go func() {
-		defer close(retval)
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (s selecter[T, E]) EnumerateE(ctx context.Context) (Enumerator[E], func() error) {
+	return enumerateSequenceE[E](ctx, s)
+}
 
-		for item := range s.original.Enumerate(ctx) {
-			select {
-			case retval <- s.transform(item):
-				// Intentionally Left Blank
-			case <-ctx.Done():
+func (s selecter[T, E]) sequence(ctx context.Context) (iter.Seq[E], func() error) {
+	original, err := asSequence(ctx, s.original)
+	return func(yield func(E) bool) {
+		for item := range original {
+			if !yield(s.transform(item)) {
 				return
 			}
 		}
-	}()
-
-	return retval
+	}, err
 }
 
 // Select creates a reusable stream of transformed values.
@@ -402,17 +840,25 @@ type selectManyer[T any, E any] struct {
 }
 
 func (s selectManyer[T, E]) Enumerate(ctx context.Context) Enumerator[E] {
-	retval := make(chan E)
+	return enumerateSequence[E](ctx, s)
+}
 
-	go func() {
-		for parent := range s.original.Enumerate(ctx) {
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (s selectManyer[T, E]) EnumerateE(ctx context.Context) (Enumerator[E], func() error) {
+	return enumerateSequenceE[E](ctx, s)
+}
+
+func (s selectManyer[T, E]) sequence(ctx context.Context) (iter.Seq[E], func() error) {
+	original, err := asSequence(ctx, s.original)
+	return func(yield func(E) bool) {
+		for parent := range original {
 			for child := range s.toMany(parent) {
-				retval <- child
+				if !yield(child) {
+					return
+				}
 			}
 		}
-		close(retval)
-	}()
-	return retval
+	}, err
 }
 
 // SelectMany allows for unfolding of values.
@@ -439,23 +885,25 @@ func SelectMany[T any, E any](subject Enumerable[T], toMany Unfolder[T, E]) Enum
 //	return retval
 //}
 
-// Single retreives the only element from a list, or returns nil and an error.
+// Single retreives the only element from a list, or returns nil and an error. If the list fails before it can be
+// determined whether there is exactly one element, its error is returned.
 func Single[T any](iter Enumerable[T]) (retval T, err error) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	err = errNoElements
+	cursor := Open(iter)
+	defer cursor.Close()
 
-	firstPass := true
-	for entry := range iter.Enumerate(ctx) {
-		if firstPass {
-			retval = entry
-			err = nil
-		} else {
-			retval = *new(T)
-			err = errMultipleElements
-			break
+	var isOpen bool
+	if retval, isOpen = cursor.Next(); !isOpen {
+		if err = cursor.Err(); err == nil {
+			err = errNoElements
 		}
-		firstPass = false
+		return
+	}
+
+	if _, isOpen = cursor.Next(); isOpen {
+		return *new(T), errMultipleElements
+	}
+	if err = cursor.Err(); err != nil {
+		return *new(T), err
 	}
 	return
 }
@@ -474,7 +922,28 @@ type skipper[T any] struct {
 }
 
 func (s skipper[T]) Enumerate(ctx context.Context) Enumerator[T] {
-	return s.original.Enumerate(ctx).Skip(s.skipCount)
+	return enumerateSequence[T](ctx, s)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (s skipper[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, s)
+}
+
+func (s skipper[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	original, err := asSequence(ctx, s.original)
+	return func(yield func(T) bool) {
+		i := uint(0)
+		for entry := range original {
+			if i < s.skipCount {
+				i++
+				continue
+			}
+			if !yield(entry) {
+				return
+			}
+		}
+	}, err
 }
 
 // Skip creates a reusable stream which will skip the first `n` elements before iterating
@@ -506,32 +975,122 @@ func (iter Enumerator[T]) Skip(n uint) Enumerator[T] {
 	return results
 }
 
-// splitN creates N Enumerators, each will be a subset of the original Enumerator and will have
-// distinct populations from one another.
-func splitN[T any, E any](iter Enumerator[T], operation Transform[T, E], n uint) []Enumerator[E] {
-	results, cast := make([]chan E, n), make([]Enumerator[E], n)
+type skipLaster[T any] struct {
+	original Enumerable[T]
+	n        uint
+}
 
-	for i := uint(0); i < n; i++ {
-		results[i] = make(chan E)
-		cast[i] = results[i]
+func (sl skipLaster[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, sl)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (sl skipLaster[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, sl)
+}
+
+func (sl skipLaster[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	original, err := asSequence(ctx, sl.original)
+	return func(yield func(T) bool) {
+		held := newRingBuffer[T](sl.n)
+		for entry := range original {
+			if evicted, ok := held.push(entry); ok && !yield(evicted) {
+				return
+			}
+		}
+	}, err
+}
+
+// SkipLast creates a reusable stream which omits the last `n` elements of an Enumerable. Only `n` elements are held in
+// memory at once, with each being produced once `n` more have been seen after it.
+func SkipLast[T any](subject Enumerable[T], n uint) Enumerable[T] {
+	return skipLaster[T]{
+		original: subject,
+		n:        n,
 	}
+}
+
+// SkipLast retreives all elements except the last 'n' elements.
+func (iter Enumerator[T]) SkipLast(n uint) Enumerator[T] {
+	results := make(chan T)
 
 	go func() {
-		for i := uint(0); i < n; i++ {
-			go func(addr uint) {
-				defer close(results[addr])
-				for {
-					read, ok := <-iter
-					if !ok {
-						return
-					}
-					results[addr] <- operation(read)
+		defer close(results)
+
+		held := newRingBuffer[T](n)
+		for entry := range iter {
+			if evicted, ok := held.push(entry); ok {
+				results <- evicted
+			}
+		}
+	}()
+
+	return results
+}
+
+type skipWhiler[T any] struct {
+	original Enumerable[T]
+	criteria func(T, uint) bool
+}
+
+func (sw skipWhiler[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, sw)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (sw skipWhiler[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, sw)
+}
+
+func (sw skipWhiler[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	original, err := asSequence(ctx, sw.original)
+	return func(yield func(T) bool) {
+		skipping := true
+		i := uint(0)
+		for entry := range original {
+			if skipping {
+				if skipping = sw.criteria(entry, i); skipping {
+					i++
+					continue
 				}
-			}(i)
+			}
+			if !yield(entry) {
+				return
+			}
+		}
+	}, err
+}
+
+// SkipWhile creates a reusable stream which omits elements for as long as some criteria is met, then produces every
+// element after that, whether or not they meet the criteria.
+func SkipWhile[T any](subject Enumerable[T], criteria func(T, uint) bool) Enumerable[T] {
+	return skipWhiler[T]{
+		original: subject,
+		criteria: criteria,
+	}
+}
+
+// SkipWhile discards items as long as 'criteria' holds true, then returns all remaining items.
+func (iter Enumerator[T]) SkipWhile(criteria func(T, uint) bool) Enumerator[T] {
+	results := make(chan T)
+
+	go func() {
+		defer close(results)
+
+		skipping := true
+		i := uint(0)
+		for entry := range iter {
+			if skipping {
+				if skipping = criteria(entry, i); skipping {
+					i++
+					continue
+				}
+			}
+			results <- entry
 		}
 	}()
 
-	return cast
+	return results
 }
 
 type taker[T any] struct {
@@ -540,7 +1099,29 @@ type taker[T any] struct {
 }
 
 func (t taker[T]) Enumerate(ctx context.Context) Enumerator[T] {
-	return t.original.Enumerate(ctx).Take(t.n)
+	return enumerateSequence[T](ctx, t)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (t taker[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, t)
+}
+
+func (t taker[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	original, err := asSequence(ctx, t.original)
+	return func(yield func(T) bool) {
+		if t.n == 0 {
+			return
+		}
+
+		i := uint(0)
+		for entry := range original {
+			i++
+			if !yield(entry) || i >= t.n {
+				return
+			}
+		}
+	}, err
 }
 
 // Take retreives just the first `n` elements from an Enumerable.
@@ -576,7 +1157,25 @@ type takeWhiler[T any] struct {
 }
 
 func (tw takeWhiler[T]) Enumerate(ctx context.Context) Enumerator[T] {
-	return tw.original.Enumerate(ctx).TakeWhile(tw.criteria)
+	return enumerateSequence[T](ctx, tw)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (tw takeWhiler[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, tw)
+}
+
+func (tw takeWhiler[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	original, err := asSequence(ctx, tw.original)
+	return func(yield func(T) bool) {
+		i := uint(0)
+		for entry := range original {
+			if !tw.criteria(entry, i) || !yield(entry) {
+				return
+			}
+			i++
+		}
+	}, err
 }
 
 // TakeWhile creates a reusable stream which will halt once some criteria is no longer met.
@@ -606,7 +1205,175 @@ func (iter Enumerator[T]) TakeWhile(criteria func(T, uint) bool) Enumerator[T] {
 	return results
 }
 
-// Tee creates two Enumerators which will have identical contents as one another.
+type takeLaster[T any] struct {
+	original Enumerable[T]
+	n        uint
+}
+
+func (tl takeLaster[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, tl)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early. Nothing is produced
+// when there is such an error, because it isn't known which elements would have been last.
+func (tl takeLaster[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, tl)
+}
+
+func (tl takeLaster[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	original, err := asSequence(ctx, tl.original)
+	return func(yield func(T) bool) {
+		held := newRingBuffer[T](tl.n)
+		for entry := range original {
+			held.push(entry)
+		}
+		if err() != nil {
+			return
+		}
+
+		for entry := range held.seq() {
+			if !yield(entry) {
+				return
+			}
+		}
+	}, err
+}
+
+// TakeLast creates a reusable stream of just the last `n` elements of an Enumerable. Only `n` elements are held in
+// memory at once, but nothing is produced until the Enumerable has ended.
+func TakeLast[T any](subject Enumerable[T], n uint) Enumerable[T] {
+	return takeLaster[T]{
+		original: subject,
+		n:        n,
+	}
+}
+
+// TakeLast retreives just the last 'n' elements from an Enumerator.
+func (iter Enumerator[T]) TakeLast(n uint) Enumerator[T] {
+	results := make(chan T)
+
+	go func() {
+		defer close(results)
+
+		held := newRingBuffer[T](n)
+		for entry := range iter {
+			held.push(entry)
+		}
+		for entry := range held.seq() {
+			results <- entry
+		}
+	}()
+
+	return results
+}
+
+type takeUntiler[T any, U any] struct {
+	original Enumerable[T]
+	signal   Enumerable[U]
+}
+
+func (tu takeUntiler[T, U]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, tu)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (tu takeUntiler[T, U]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, tu)
+}
+
+func (tu takeUntiler[T, U]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	var err error
+	return func(yield func(T) bool) {
+		child, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// Once the signal produces a value, enumeration of the original is cancelled, so that this stops promptly even
+		// if the original is waiting for its next value.
+		fired := make(chan struct{})
+		watched := make(chan struct{})
+		go func() {
+			defer close(watched)
+			if _, ok := <-tu.signal.Enumerate(child); ok {
+				close(fired)
+				cancel()
+			}
+		}()
+		defer func() {
+			cancel()
+			<-watched
+		}()
+
+		original, originalErr := asSequence(child, tu.original)
+		for entry := range original {
+			select {
+			case <-fired:
+				return
+			default:
+				// Intentionally Left Blank
+			}
+
+			if !yield(entry) {
+				return
+			}
+		}
+
+		select {
+		case <-fired:
+			// Intentionally Left Blank
+		default:
+			err = originalErr()
+		}
+	}, func() error { return err }
+}
+
+// TakeUntil creates a reusable stream which halts as soon as `signal` produces a value. Both are enumerated at the same
+// time, and enumeration of each is cancelled once the stream halts. If signal ends without producing a value, every
+// element is produced. Any error encountered by signal is ignored.
+func TakeUntil[T any, U any](subject Enumerable[T], signal Enumerable[U]) Enumerable[T] {
+	return takeUntiler[T, U]{
+		original: subject,
+		signal:   signal,
+	}
+}
+
+// TakeUntil continues returning items until 'signal' produces a value. If signal is closed without producing a value,
+// every item is returned, just as with the TakeUntil function. Because methods can't introduce type parameters of their
+// own, signal is a plain channel; to halt on the values of an Enumerable, use the TakeUntil function instead.
+func (iter Enumerator[T]) TakeUntil(signal <-chan struct{}) Enumerator[T] {
+	results := make(chan T)
+
+	go func() {
+		defer close(results)
+		for {
+			select {
+			case entry, ok := <-iter:
+				if !ok {
+					return
+				}
+				select {
+				case results <- entry:
+					// Intentionally Left Blank
+				case _, fired := <-signal:
+					if fired {
+						return
+					}
+					signal = nil
+					results <- entry
+				}
+			case _, fired := <-signal:
+				if fired {
+					return
+				}
+				signal = nil
+			}
+		}
+	}()
+
+	return results
+}
+
+// Tee creates two Enumerators which will have identical contents as one another. Both must be consumed at the same pace;
+// use TeeN when that isn't the case.
 func (iter Enumerator[T]) Tee() (Enumerator[T], Enumerator[T]) {
 	left, right := make(chan T), make(chan T)
 
@@ -636,24 +1403,41 @@ func (iter Enumerator[T]) ToSlice() []T {
 	return retval
 }
 
+// ToSliceE places all iterated over values in a Slice for easy consumption. If the enumeration ended early because of an
+// error, the values which were seen are returned along with that error.
+func ToSliceE[T any](subject Enumerable[T]) ([]T, error) {
+	seq, err := asSequence(context.Background(), subject)
+
+	retval := make([]T, 0)
+	for entry := range seq {
+		retval = append(retval, entry)
+	}
+	return retval, err()
+}
+
 type wherer[T any] struct {
 	original Enumerable[T]
 	filter   Predicate[T]
 }
 
 func (w wherer[T]) Enumerate(ctx context.Context) Enumerator[T] {
-	retval := make(chan T)
+	return enumerateSequence[T](ctx, w)
+}
 
-	go func() {
-		defer close(retval)
-		for entry := range w.original.Enumerate(ctx) {
-			if w.filter(entry) {
-				retval <- entry
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early.
+func (w wherer[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, w)
+}
+
+func (w wherer[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	original, err := asSequence(ctx, w.original)
+	return func(yield func(T) bool) {
+		for entry := range original {
+			if w.filter(entry) && !yield(entry) {
+				return
 			}
 		}
-	}()
-
-	return retval
+	}, err
 }
 
 // Where creates a reusable means of filtering a stream.
@@ -711,3 +1495,43 @@ func (iter Enumerator[T]) UCountAll() uint {
 	}
 	return tally
 }
+
+// ringBuffer holds the most recent values pushed into it, up to a fixed capacity.
+type ringBuffer[T any] struct {
+	entries []T
+	start   int
+}
+
+func newRingBuffer[T any](capacity uint) *ringBuffer[T] {
+	return &ringBuffer[T]{
+		entries: make([]T, 0, capacity),
+	}
+}
+
+// push adds a value to the buffer. If the buffer was already full, the oldest value is evicted to make room, and is
+// returned. A buffer with no capacity evicts each value as soon as it is pushed.
+func (r *ringBuffer[T]) push(value T) (evicted T, ok bool) {
+	if len(r.entries) < cap(r.entries) {
+		r.entries = append(r.entries, value)
+		return
+	}
+	if len(r.entries) == 0 {
+		return value, true
+	}
+
+	evicted = r.entries[r.start]
+	r.entries[r.start] = value
+	r.start = (r.start + 1) % len(r.entries)
+	return evicted, true
+}
+
+// seq iterates over the values in the buffer, from oldest to newest.
+func (r *ringBuffer[T]) seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range r.entries {
+			if !yield(r.entries[(r.start+i)%len(r.entries)]) {
+				return
+			}
+		}
+	}
+}
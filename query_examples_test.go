@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/marstr/collection/v2"
 )
@@ -62,6 +63,22 @@ func ExampleEnumerator_ElementAt() {
 	// Output: 3
 }
 
+func ExampleElementAtE() {
+	subject := collection.AsEnumerable("a", "b", "c")
+
+	fmt.Println(collection.ElementAtE(subject, 1))
+	fmt.Println(collection.ElementAtE(subject, 3))
+	// Output:
+	// b <nil>
+	//  enumerator encountered no elements
+}
+
+func ExampleElementAtOrDefault() {
+	subject := collection.AsEnumerable(1, 2, 3)
+	fmt.Println(collection.ElementAtOrDefault(subject, 10, -1))
+	// Output: -1
+}
+
 func ExampleFirst() {
 	empty := collection.NewQueue[int]()
 	notEmpty := collection.NewQueue(1, 2, 3, 4)
@@ -74,6 +91,30 @@ func ExampleFirst() {
 	// 1 <nil>
 }
 
+func ExampleFirstOrDefault() {
+	empty := collection.NewQueue[int]()
+	fmt.Println(collection.FirstOrDefault[int](empty, 42))
+	// Output: 42
+}
+
+func ExampleFirstp() {
+	subject := collection.AsEnumerable(1, 2, 3, 4)
+	fmt.Println(collection.Firstp(subject, func(x int) bool {
+		return x%2 == 0
+	}))
+	// Output: 2 <nil>
+}
+
+func ExampleEnumerator_Firstp() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fmt.Println(collection.Fibonacci.Enumerate(ctx).Firstp(func(x uint) bool {
+		return x > 50
+	}))
+	// Output: 55 <nil>
+}
+
 func ExampleLast() {
 	subject := collection.NewList(1, 2, 3, 4)
 	fmt.Println(collection.Last[int](subject))
@@ -86,6 +127,25 @@ func ExampleEnumerator_Last() {
 	//Output: 3
 }
 
+func ExampleLastOrDefault() {
+	subject := collection.AsEnumerable(0)
+	empty := collection.AsEnumerable[int]()
+
+	fmt.Println(collection.LastOrDefault(subject, -1))
+	fmt.Println(collection.LastOrDefault(empty, -1))
+	// Output:
+	// 0
+	// -1
+}
+
+func ExampleLastp() {
+	subject := collection.AsEnumerable(1, 2, 3, 4, 5)
+	fmt.Println(collection.Lastp(subject, func(x int) bool {
+		return x%2 == 0
+	}))
+	// Output: 4 <nil>
+}
+
 func ExampleMerge() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -109,6 +169,18 @@ func ExampleMerge() {
 	// 8
 }
 
+func ExamplePartition() {
+	evens, odds := collection.Partition(collection.Range(1, 10), func(x int) bool {
+		return x%2 == 0
+	})
+
+	fmt.Println(collection.ToSlice(odds))
+	fmt.Println(collection.ToSlice(evens))
+	// Output:
+	// [1 3 5 7 9]
+	// [2 4 6 8 10]
+}
+
 func ExampleEnumerator_Reverse() {
 	a := collection.AsEnumerable(1, 2, 3).Enumerate(context.Background())
 	a = a.Reverse()
@@ -208,6 +280,35 @@ func ExampleEnumerator_Skip() {
 	// 7
 }
 
+func ExampleSkipLast() {
+	trimmed := collection.SkipLast(collection.Range(1, 5), 2)
+	fmt.Println(collection.ToSlice(trimmed))
+	// Output: [1 2 3]
+}
+
+func ExampleEnumerator_SkipLast() {
+	subject := collection.AsEnumerable("a", "b", "c", "d")
+	fmt.Println(subject.Enumerate(context.Background()).SkipLast(3).ToSlice())
+	// Output: [a]
+}
+
+func ExampleSkipWhile() {
+	skipped := collection.SkipWhile(collection.Fibonacci, func(x, n uint) bool {
+		return x < 10
+	})
+	fmt.Println(collection.ToSlice(collection.Take(skipped, 3)))
+	// Output: [13 21 34]
+}
+
+func ExampleEnumerator_SkipWhile() {
+	subject := collection.AsEnumerable(1, 2, 5, 1, 2)
+	skipped := subject.Enumerate(context.Background()).SkipWhile(func(x int, n uint) bool {
+		return x < 3
+	})
+	fmt.Println(skipped.ToSlice())
+	// Output: [5 1 2]
+}
+
 func ExampleTake() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -275,6 +376,60 @@ func ExampleEnumerator_TakeWhile() {
 	// 5
 }
 
+func ExampleTakeLast() {
+	taken := collection.TakeLast(collection.Range(1, 100), 3)
+	fmt.Println(collection.ToSlice(taken))
+	// Output: [98 99 100]
+}
+
+func ExampleEnumerator_TakeLast() {
+	subject := collection.AsEnumerable("a", "b", "c", "d")
+	fmt.Println(subject.Enumerate(context.Background()).TakeLast(2).ToSlice())
+	// Output: [c d]
+}
+
+func ExampleTakeUntil() {
+	ticks := collection.Generate(func(ctx context.Context) (int, bool) {
+		time.Sleep(time.Millisecond)
+		return 0, true
+	})
+	signal := collection.Generate(func(ctx context.Context) (struct{}, bool) {
+		select {
+		case <-time.After(50 * time.Millisecond):
+			return struct{}{}, true
+		case <-ctx.Done():
+			return struct{}{}, false
+		}
+	})
+
+	count := collection.CountAll(collection.TakeUntil(ticks, signal))
+	fmt.Println(count > 0)
+	// Output: true
+}
+
+func ExampleEnumerator_TakeUntil() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stop := make(chan struct{}, 1)
+	taken := collection.Fibonacci.Enumerate(ctx).TakeUntil(stop)
+	for entry := range taken {
+		if entry > 10 {
+			stop <- struct{}{}
+			break
+		}
+		fmt.Println(entry)
+	}
+	// Output:
+	// 0
+	// 1
+	// 1
+	// 2
+	// 3
+	// 5
+	// 8
+}
+
 func ExampleEnumerator_Tee() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
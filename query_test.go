@@ -2,6 +2,9 @@ package collection
 
 import (
 	"context"
+	"errors"
+	"runtime"
+	"sync"
 	"testing"
 	"time"
 )
@@ -41,6 +44,96 @@ func BenchmarkEnumerator_Sum(b *testing.B) {
 	}
 }
 
+// pipelineStages are shared by the pipeline benchmarks, so that the channel-per-stage model used by Enumerator can be
+// compared against the fused model used by Enumerable.
+var pipelineStages = struct {
+	odd      Predicate[int]
+	notFifth Predicate[int]
+	skip     uint
+	take     uint
+}{
+	odd:      func(x int) bool { return x%2 == 1 },
+	notFifth: func(x int) bool { return x%5 != 0 },
+	skip:     10,
+	take:     300,
+}
+
+func BenchmarkEnumerator_pipeline(b *testing.B) {
+	var nums EnumerableSlice[int] = getInitializedSequentialArray[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		results := nums.Enumerate(ctx).
+			Where(pipelineStages.odd).
+			Where(pipelineStages.notFifth).
+			Skip(pipelineStages.skip).
+			Take(pipelineStages.take)
+		results.Discard()
+	}
+}
+
+func BenchmarkEnumerable_pipeline(b *testing.B) {
+	var nums EnumerableSlice[int] = getInitializedSequentialArray[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var results Enumerable[int] = nums
+	results = Where(results, pipelineStages.odd)
+	results = Where(results, pipelineStages.notFifth)
+	results = Skip(results, pipelineStages.skip)
+	results = Take(results, pipelineStages.take)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		results.Enumerate(ctx).Discard()
+	}
+}
+
+func BenchmarkEnumerable_pipelineSeq(b *testing.B) {
+	var nums EnumerableSlice[int] = getInitializedSequentialArray[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var results Enumerable[int] = nums
+	results = Where(results, pipelineStages.odd)
+	results = Where(results, pipelineStages.notFifth)
+	results = Skip(results, pipelineStages.skip)
+	results = Take(results, pipelineStages.take)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		seq, _ := asSequence(ctx, results)
+		for range seq {
+			// Intentionally Left Blank
+		}
+	}
+}
+
+func TestTake_stopsUpstream(t *testing.T) {
+	pulled := 0
+	subject := FromSeq(func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	got := ToSlice(Take(Where(subject, func(x int) bool { return x%2 == 0 }), 3))
+	if len(got) != 3 || got[2] != 4 {
+		t.Logf("got: %v\nwant: %v", got, []int{0, 2, 4})
+		t.Fail()
+	}
+
+	if pulled != 5 {
+		t.Logf("got: %d pulls\nwant: %d pulls", pulled, 5)
+		t.Fail()
+	}
+}
+
 func sleepIdentity[T any](val T) T {
 	time.Sleep(2 * time.Millisecond)
 	return val
@@ -53,3 +146,418 @@ func getInitializedSequentialArray[T ~int]() []T {
 	}
 	return rawNums
 }
+
+func TestSkipLast_TakeLast(t *testing.T) {
+	testCases := []struct {
+		subject  []int
+		n        uint
+		skipLast []int
+		takeLast []int
+	}{
+		{[]int{}, 2, []int{}, []int{}},
+		{[]int{1, 2, 3}, 0, []int{1, 2, 3}, []int{}},
+		{[]int{1, 2, 3}, 1, []int{1, 2}, []int{3}},
+		{[]int{1, 2, 3}, 3, []int{}, []int{1, 2, 3}},
+		{[]int{1, 2, 3}, 5, []int{}, []int{1, 2, 3}},
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 3, []int{1, 2, 3, 4}, []int{5, 6, 7}},
+	}
+
+	same := func(got, want []int) bool {
+		if len(got) != len(want) {
+			return false
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, tc := range testCases {
+		subject := EnumerableSlice[int](tc.subject)
+
+		if got := ToSlice(SkipLast[int](subject, tc.n)); !same(got, tc.skipLast) {
+			t.Logf("SkipLast(%v, %d)\ngot: %v\nwant: %v", tc.subject, tc.n, got, tc.skipLast)
+			t.Fail()
+		}
+		if got := subject.Enumerate(context.Background()).SkipLast(tc.n).ToSlice(); !same(got, tc.skipLast) {
+			t.Logf("Enumerator.SkipLast(%v, %d)\ngot: %v\nwant: %v", tc.subject, tc.n, got, tc.skipLast)
+			t.Fail()
+		}
+		if got := ToSlice(TakeLast[int](subject, tc.n)); !same(got, tc.takeLast) {
+			t.Logf("TakeLast(%v, %d)\ngot: %v\nwant: %v", tc.subject, tc.n, got, tc.takeLast)
+			t.Fail()
+		}
+		if got := subject.Enumerate(context.Background()).TakeLast(tc.n).ToSlice(); !same(got, tc.takeLast) {
+			t.Logf("Enumerator.TakeLast(%v, %d)\ngot: %v\nwant: %v", tc.subject, tc.n, got, tc.takeLast)
+			t.Fail()
+		}
+	}
+}
+
+func TestTakeLast_Error(t *testing.T) {
+	errBroken := errors.New("broken")
+	broken := FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) {
+			yield(0, errBroken)
+		}
+	})
+
+	got, err := ToSliceE(TakeLast(broken, 2))
+	if !errors.Is(err, errBroken) {
+		t.Logf("got: %v\nwant: %v", err, errBroken)
+		t.Fail()
+	}
+	if len(got) != 0 {
+		t.Logf("got: %v\nwant: no values", got)
+		t.Fail()
+	}
+}
+
+func TestTakeUntil_StopsWaitingUpstream(t *testing.T) {
+	blocked := Generate(func(ctx context.Context) (int, bool) {
+		<-ctx.Done()
+		return 0, false
+	})
+	signal := AsEnumerable(struct{}{})
+
+	got, err := ToSliceE(TakeUntil(Prepend(blocked, 1), signal))
+	if err != nil {
+		t.Error(err)
+	}
+	if len(got) > 1 {
+		t.Logf("got: %v\nwant: at most %v", got, []int{1})
+		t.Fail()
+	}
+}
+
+func TestTakeUntil_SilentSignal(t *testing.T) {
+	got, err := ToSliceE(TakeUntil(Range(0, 5), AsEnumerable[string]()))
+	if err != nil {
+		t.Error(err)
+	}
+	if len(got) != 5 {
+		t.Logf("got: %v\nwant: %v", got, []int{0, 1, 2, 3, 4})
+		t.Fail()
+	}
+}
+
+func TestEnumerator_TakeUntil(t *testing.T) {
+	closed := make(chan struct{})
+	close(closed)
+	if got := AsEnumerable(0, 1, 2).Enumerate(context.Background()).TakeUntil(closed).ToSlice(); len(got) != 3 {
+		t.Logf("a signal closed without a value should be ignored\ngot: %v\nwant: %v", got, []int{0, 1, 2})
+		t.Fail()
+	}
+
+	fired := make(chan struct{}, 1)
+	fired <- struct{}{}
+	waiting := make(chan int)
+	if got := Enumerator[int](waiting).TakeUntil(fired).ToSlice(); len(got) != 0 {
+		t.Logf("got: %v\nwant: no values", got)
+		t.Fail()
+	}
+}
+
+func TestPartition(t *testing.T) {
+	evaluations := 0
+	matched, unmatched := Partition(Range(0, 10), func(x int) bool {
+		evaluations++
+		return x%3 == 0
+	})
+
+	// Consuming one half entirely before the other must not deadlock.
+	gotUnmatched := ToSlice(unmatched)
+	gotMatched, err := ToSliceE(matched)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if want := []int{1, 2, 4, 5, 7, 8}; len(gotUnmatched) != len(want) {
+		t.Logf("got: %v\nwant: %v", gotUnmatched, want)
+		t.Fail()
+	}
+	if want := []int{0, 3, 6, 9}; len(gotMatched) != len(want) || gotMatched[3] != 9 {
+		t.Logf("got: %v\nwant: %v", gotMatched, want)
+		t.Fail()
+	}
+
+	if _, err := ToSliceE(matched); err != ErrPartitionConsumed {
+		t.Logf("got: %v\nwant: %v", err, ErrPartitionConsumed)
+		t.Fail()
+	}
+
+	if evaluations != 10 {
+		t.Logf("got: %d evaluations\nwant: %d evaluations", evaluations, 10)
+		t.Fail()
+	}
+}
+
+func TestPartition_Concurrent(t *testing.T) {
+	matched, unmatched := Partition(Range(0, 1000), func(x int) bool {
+		return x%2 == 0
+	})
+
+	var wg sync.WaitGroup
+	sums := make([]int, 2)
+	for i, half := range []Enumerable[int]{matched, unmatched} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for entry := range half.Enumerate(context.Background()) {
+				sums[i] += entry
+			}
+		}()
+	}
+	wg.Wait()
+
+	if want := []int{249500, 250000}; sums[0] != want[0] || sums[1] != want[1] {
+		t.Logf("got: %v\nwant: %v", sums, want)
+		t.Fail()
+	}
+}
+
+func TestPartition_Abandoned(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 100; i++ {
+		evens, _ := Partition[uint](Fibonacci, func(x uint) bool {
+			return x%2 == 0
+		})
+		if got := ToSlice(Take(evens, 3)); len(got) != 3 {
+			t.Logf("got: %v\nwant: %v", got, []uint{0, 2, 8})
+			t.FailNow()
+		}
+	}
+
+	// Goroutines which have been released may take a moment to exit.
+	after := runtime.NumGoroutine()
+	for deadline := time.Now().Add(time.Second); after > before && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+		after = runtime.NumGoroutine()
+	}
+	if after > before {
+		t.Logf("got: %d goroutines\nwant: at most %d goroutines", after, before)
+		t.Fail()
+	}
+}
+
+func TestPartition_LateHalf(t *testing.T) {
+	evens, odds := Partition[uint](Fibonacci, func(x uint) bool {
+		return x%2 == 0
+	})
+	ToSlice(Take(evens, 3))
+
+	got, err := ToSliceE(odds)
+	if want := []uint{1, 1, 3, 5}; len(got) != len(want) || got[3] != 5 {
+		t.Logf("got: %v\nwant: %v", got, want)
+		t.Fail()
+	}
+	if err != ErrPartitionConsumed {
+		t.Logf("got: %v\nwant: %v", err, ErrPartitionConsumed)
+		t.Fail()
+	}
+}
+
+func TestPartition_CancelledWhileSourceBlocked(t *testing.T) {
+	produced := 0
+	matched, unmatched := Partition(Generate(func(ctx context.Context) (int, bool) {
+		if produced < 2 {
+			produced++
+			return produced, true
+		}
+		<-ctx.Done()
+		return 0, false
+	}), func(x int) bool {
+		return x%2 == 0
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	results, err := EnumerateE(ctx, matched)
+
+	finished := make(chan []int)
+	go func() {
+		finished <- results.ToSlice()
+	}()
+
+	// While the matched half waits on the source, the unmatched half is still able to read what was buffered for it.
+	time.Sleep(10 * time.Millisecond)
+	unmatchedCtx, unmatchedCancel := context.WithCancel(context.Background())
+	defer unmatchedCancel()
+	select {
+	case got, ok := <-unmatched.Enumerate(unmatchedCtx):
+		if !ok || got != 1 {
+			t.Logf("got: %d %v\nwant: %d", got, ok, 1)
+			t.Fail()
+		}
+	case <-time.After(time.Second):
+		t.Log("half was unable to read its buffer while the other half waited on the source")
+		t.Fail()
+	}
+
+	cancel()
+	select {
+	case got := <-finished:
+		if len(got) != 1 || got[0] != 2 {
+			t.Logf("got: %v\nwant: %v", got, []int{2})
+			t.Fail()
+		}
+	case <-time.After(time.Second):
+		t.Log("half kept waiting on the source after its context was cancelled")
+		t.FailNow()
+	}
+
+	if !errors.Is(err(), context.Canceled) {
+		t.Logf("got: %v\nwant: %v", err(), context.Canceled)
+		t.Fail()
+	}
+}
+
+func TestPartition_Error(t *testing.T) {
+	errBroken := errors.New("broken")
+	broken := FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) {
+			yield(0, errBroken)
+		}
+	})
+
+	matched, unmatched := Partition[int](broken, func(x int) bool { return x > 0 })
+	for _, half := range []Enumerable[int]{matched, unmatched} {
+		if _, err := ToSliceE(half); !errors.Is(err, errBroken) {
+			t.Logf("got: %v\nwant: %v", err, errBroken)
+			t.Fail()
+		}
+	}
+}
+
+func TestElementAtE(t *testing.T) {
+	errBroken := errors.New("broken")
+	broken := FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) {
+			yield(0, errBroken)
+		}
+	})
+
+	// Errors can only be reported by the Enumerable form, so those cases aren't repeated for the Enumerator form.
+	testCases := []struct {
+		subject        Enumerable[int]
+		n              uint
+		want           int
+		wantErr        error
+		enumerableOnly bool
+	}{
+		{AsEnumerable[int](), 0, 0, errNoElements, false},
+		{AsEnumerable(0, 1, 2), 0, 0, nil, false},
+		{AsEnumerable(0, 1, 2), 2, 2, nil, false},
+		{AsEnumerable(0, 1, 2), 3, 0, errNoElements, false},
+		{broken, 0, 1, nil, true},
+		{broken, 1, 0, errBroken, true},
+	}
+
+	for _, tc := range testCases {
+		if got, err := ElementAtE(tc.subject, tc.n); got != tc.want || !errors.Is(err, tc.wantErr) {
+			t.Logf("ElementAtE(%d)\ngot: %d, %v\nwant: %d, %v", tc.n, got, err, tc.want, tc.wantErr)
+			t.Fail()
+		}
+
+		if tc.enumerableOnly {
+			continue
+		}
+		if got, err := tc.subject.Enumerate(context.Background()).ElementAtE(tc.n); got != tc.want || err != tc.wantErr {
+			t.Logf("Enumerator.ElementAtE(%d)\ngot: %d, %v\nwant: %d, %v", tc.n, got, err, tc.want, tc.wantErr)
+			t.Fail()
+		}
+	}
+}
+
+func TestFirst_Single_SourceError(t *testing.T) {
+	errBroken := errors.New("broken")
+	failsImmediately := FromSeqE(func(yield func(int, error) bool) {
+		yield(0, errBroken)
+	})
+	failsAfterOne := FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) {
+			yield(0, errBroken)
+		}
+	})
+
+	if got, err := First[int](failsImmediately); !errors.Is(err, errBroken) {
+		t.Logf("First\ngot: %d, %v\nwant: %v", got, err, errBroken)
+		t.Fail()
+	}
+	if got, err := First[int](failsAfterOne); err != nil || got != 1 {
+		t.Logf("First\ngot: %d, %v\nwant: %d, %v", got, err, 1, nil)
+		t.Fail()
+	}
+
+	for _, subject := range []Enumerable[int]{failsImmediately, failsAfterOne} {
+		if got, err := Single(subject); !errors.Is(err, errBroken) || got != 0 {
+			t.Logf("Single\ngot: %d, %v\nwant: %d, %v", got, err, 0, errBroken)
+			t.Fail()
+		}
+	}
+}
+
+func TestFirstp_Lastp(t *testing.T) {
+	even := func(x int) bool { return x%2 == 0 }
+
+	testCases := []struct {
+		subject   []int
+		wantFirst int
+		wantLast  int
+		wantErr   error
+	}{
+		{[]int{}, 0, 0, errNoElements},
+		{[]int{1, 3, 5}, 0, 0, errNoElements},
+		{[]int{1, 0, 3}, 0, 0, nil},
+		{[]int{1, 2, 3, 4, 5}, 2, 4, nil},
+	}
+
+	for _, tc := range testCases {
+		subject := EnumerableSlice[int](tc.subject)
+
+		if got, err := Firstp[int](subject, even); got != tc.wantFirst || err != tc.wantErr {
+			t.Logf("Firstp(%v)\ngot: %d, %v\nwant: %d, %v", tc.subject, got, err, tc.wantFirst, tc.wantErr)
+			t.Fail()
+		}
+		if got, err := subject.Enumerate(context.Background()).Firstp(even); got != tc.wantFirst || err != tc.wantErr {
+			t.Logf("Enumerator.Firstp(%v)\ngot: %d, %v\nwant: %d, %v", tc.subject, got, err, tc.wantFirst, tc.wantErr)
+			t.Fail()
+		}
+		if got, err := Lastp[int](subject, even); got != tc.wantLast || err != tc.wantErr {
+			t.Logf("Lastp(%v)\ngot: %d, %v\nwant: %d, %v", tc.subject, got, err, tc.wantLast, tc.wantErr)
+			t.Fail()
+		}
+		if got, err := subject.Enumerate(context.Background()).Lastp(even); got != tc.wantLast || err != tc.wantErr {
+			t.Logf("Enumerator.Lastp(%v)\ngot: %d, %v\nwant: %d, %v", tc.subject, got, err, tc.wantLast, tc.wantErr)
+			t.Fail()
+		}
+	}
+}
+
+func TestOrDefault(t *testing.T) {
+	empty := AsEnumerable[int]()
+	zero := AsEnumerable(0)
+
+	if got := FirstOrDefault(empty, 7); got != 7 {
+		t.Logf("FirstOrDefault\ngot: %d\nwant: %d", got, 7)
+		t.Fail()
+	}
+	if got := FirstOrDefault(zero, 7); got != 0 {
+		t.Logf("FirstOrDefault\ngot: %d\nwant: %d", got, 0)
+		t.Fail()
+	}
+	if got := empty.Enumerate(context.Background()).FirstOrDefault(7); got != 7 {
+		t.Logf("Enumerator.FirstOrDefault\ngot: %d\nwant: %d", got, 7)
+		t.Fail()
+	}
+	if got := zero.Enumerate(context.Background()).LastOrDefault(7); got != 0 {
+		t.Logf("Enumerator.LastOrDefault\ngot: %d\nwant: %d", got, 0)
+		t.Fail()
+	}
+	if got := empty.Enumerate(context.Background()).ElementAtOrDefault(2, 7); got != 7 {
+		t.Logf("Enumerator.ElementAtOrDefault\ngot: %d\nwant: %d", got, 7)
+		t.Fail()
+	}
+}
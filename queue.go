@@ -2,6 +2,7 @@ package collection
 
 import (
 	"context"
+	"iter"
 	"sync"
 )
 
@@ -29,6 +30,25 @@ func (q *Queue[T]) Add(entry T) {
 	q.underlyer.AddBack(entry)
 }
 
+// All returns an iterator over each position and value in the Queue, beginning with the next item to be removed.
+//
+// The Queue is locked for reading while the iterator runs, so it must not be modified from within the loop body.
+func (q *Queue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		q.key.RLock()
+		defer q.key.RUnlock()
+
+		if q.underlyer == nil {
+			return
+		}
+		for i, entry := range q.underlyer.All() {
+			if !yield(i, entry) {
+				return
+			}
+		}
+	}
+}
+
 // Enumerate peeks at each element of this queue without mutating it.
 func (q *Queue[T]) Enumerate(ctx context.Context) Enumerator[T] {
 	q.key.RLock()
@@ -73,6 +93,19 @@ func (q *Queue[T]) Peek() (T, bool) {
 	return q.underlyer.PeekFront()
 }
 
+// Seq returns an iterator over each value in the Queue, beginning with the next item to be removed.
+//
+// The Queue is locked for reading while the iterator runs, so it must not be modified from within the loop body.
+func (q *Queue[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, entry := range q.All() {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
 // ToSlice converts a Queue into a slice.
 func (q *Queue[T]) ToSlice() []T {
 	q.key.RLock()
@@ -51,6 +51,20 @@ func ExampleQueue_Next() {
 	// 13
 }
 
+func ExampleQueue_Seq() {
+	subject := NewQueue(1, 2, 3, 5, 8, 13)
+	for entry := range subject.Seq() {
+		fmt.Println(entry)
+	}
+	// Output:
+	// 1
+	// 2
+	// 3
+	// 5
+	// 8
+	// 13
+}
+
 func TestQueue_Length(t *testing.T) {
 	empty := NewQueue[int]()
 	if count := empty.Length(); count != 0 {
@@ -162,3 +176,11 @@ func TestQueue_ToSlice_NotConstructed(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestQueue_Seq_NotConstructed(t *testing.T) {
+	subject := &Queue[int]{}
+	for entry := range subject.Seq() {
+		t.Logf("unexpected entry: %v", entry)
+		t.Fail()
+	}
+}
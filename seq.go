@@ -0,0 +1,169 @@
+package collection
+
+import (
+	"context"
+	"iter"
+)
+
+type seqEnumerable[T any] iter.Seq[T]
+
+// FromSeq wraps an iterator so that it may be used anywhere an Enumerable is expected.
+//
+// The iterator is invoked once for each call to Enumerate, so it should be safe to run more than once if the resulting
+// Enumerable will be.
+func FromSeq[T any](seq iter.Seq[T]) Enumerable[T] {
+	return seqEnumerable[T](seq)
+}
+
+// Enumerate runs the wrapped iterator, publishing each value it produces.
+func (s seqEnumerable[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, s)
+}
+
+// EnumerateE runs the wrapped iterator, publishing each value it produces. The only error that will be reported is the
+// cancellation of ctx.
+func (s seqEnumerable[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, s)
+}
+
+// Seq returns the wrapped iterator.
+func (s seqEnumerable[T]) Seq() iter.Seq[T] {
+	return iter.Seq[T](s)
+}
+
+func (s seqEnumerable[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	return withContext(ctx, iter.Seq[T](s))
+}
+
+type seqEnumerableE[T any] iter.Seq2[T, error]
+
+// FromSeqE wraps an iterator which may fail so that it may be used anywhere an Enumerable is expected. Enumeration
+// ends at the first non-nil error the iterator produces, and that error is reported by EnumerateE.
+//
+// The iterator is invoked once for each call to Enumerate, so it should be safe to run more than once if the resulting
+// Enumerable will be.
+func FromSeqE[T any](seq iter.Seq2[T, error]) EnumerableE[T] {
+	return seqEnumerableE[T](seq)
+}
+
+// Enumerate runs the wrapped iterator, publishing each value it produces until it reports an error.
+func (s seqEnumerableE[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, s)
+}
+
+// EnumerateE runs the wrapped iterator, publishing each value it produces until it reports an error.
+func (s seqEnumerableE[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, s)
+}
+
+func (s seqEnumerableE[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	var err error
+	return func(yield func(T) bool) {
+		for entry, entryErr := range s {
+			if entryErr != nil {
+				err = entryErr
+				return
+			}
+			if err = ctx.Err(); err != nil || !yield(entry) {
+				return
+			}
+		}
+	}, func() error { return err }
+}
+
+// sequencer is implemented by Enumerables which are able to push their values into a yield function on the caller's
+// goroutine. Chained sequencers are fused together into a single loop, rather than each stage of a query needing its own
+// goroutine and channel.
+type sequencer[T any] interface {
+	// sequence returns an iterator, and a function which reports the error (if any) that caused the iterator to stop
+	// early. The error function is only meaningful once the iterator has returned.
+	sequence(ctx context.Context) (iter.Seq[T], func() error)
+}
+
+// asSequence finds the cheapest way to iterate over an Enumerable. Stages of a query which implement sequencer, and
+// data structures which expose an iterator, are used directly. Any other Enumerable is drained from its Enumerator,
+// which is cancelled if the caller stops iterating early.
+func asSequence[T any](ctx context.Context, subject Enumerable[T]) (iter.Seq[T], func() error) {
+	switch cast := subject.(type) {
+	case sequencer[T]:
+		return cast.sequence(ctx)
+	case interface{ Seq() iter.Seq[T] }:
+		return withContext(ctx, cast.Seq())
+	}
+
+	var err error
+	return func(yield func(T) bool) {
+		child, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results, resultsErr := EnumerateE(child, subject)
+		for entry := range results {
+			if !yield(entry) {
+				return
+			}
+		}
+		err = resultsErr()
+	}, func() error { return err }
+}
+
+// withContext stops an iterator before the next value is produced once a context has been cancelled, and reports the
+// cancellation as an error.
+func withContext[T any](ctx context.Context, seq iter.Seq[T]) (iter.Seq[T], func() error) {
+	var err error
+	return func(yield func(T) bool) {
+		for entry := range seq {
+			if err = ctx.Err(); err != nil || !yield(entry) {
+				return
+			}
+		}
+	}, func() error { return err }
+}
+
+// noError is the error function for sequences which cannot fail.
+func noError() error {
+	return nil
+}
+
+// firstError combines the error functions of several sequences, reporting the first non-nil error among them.
+func firstError(errs ...func() error) func() error {
+	return func() error {
+		for _, err := range errs {
+			if current := err(); current != nil {
+				return current
+			}
+		}
+		return nil
+	}
+}
+
+// enumerateSequence publishes each value produced by a sequencer to a channel, stopping early if the context is
+// cancelled.
+func enumerateSequence[T any](ctx context.Context, subject sequencer[T]) Enumerator[T] {
+	results, _ := enumerateSequenceE(ctx, subject)
+	return results
+}
+
+// enumerateSequenceE publishes each value produced by a sequencer to a channel, stopping early if the context is
+// cancelled. The returned function reports why the sequence ended, and must not be called until the channel is closed.
+func enumerateSequenceE[T any](ctx context.Context, subject sequencer[T]) (Enumerator[T], func() error) {
+	results := make(chan T)
+	var err error
+
+	go func() {
+		defer close(results)
+
+		seq, seqErr := subject.sequence(ctx)
+		for entry := range seq {
+			select {
+			case results <- entry:
+				// Intentionally Left Blank
+			case <-ctx.Done():
+				err = ctx.Err()
+				return
+			}
+		}
+		err = seqErr()
+	}()
+
+	return results, func() error { return err }
+}
@@ -0,0 +1,104 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleFromSeq() {
+	subject := NewLinkedList(1, 2, 3, 4, 5)
+	evens := Where(FromSeq(subject.Seq()), func(x int) bool {
+		return x%2 == 0
+	})
+	fmt.Println(ToSlice(evens))
+	// Output: [2 4]
+}
+
+func TestFromSeq_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	subject := FromSeq(Dictionary{}.Seq())
+	for range subject.Enumerate(ctx) {
+		t.Log("empty Dictionary should not have produced any values")
+		t.Fail()
+	}
+
+	stopped := make(chan struct{})
+	infinite := FromSeq(func(yield func(int) bool) {
+		defer close(stopped)
+		for i := 0; yield(i); i++ {
+			// Intentionally Left Blank
+		}
+	})
+
+	results := infinite.Enumerate(ctx)
+	<-results
+	cancel()
+	<-stopped
+}
+
+func ExampleFromSeqE() {
+	lines := FromSeqE(func(yield func(string, error) bool) {
+		if !yield("first", nil) || !yield("second", nil) {
+			return
+		}
+		yield("", errors.New("connection reset"))
+	})
+
+	results, err := ToSliceE(Select(lines, func(line string) int {
+		return len(line)
+	}))
+	fmt.Println(results, err)
+
+	results, err = ToSliceE(Take(Select(lines, func(line string) int {
+		return len(line)
+	}), 1))
+	fmt.Println(results, err)
+	// Output:
+	// [5 6] connection reset
+	// [5] <nil>
+}
+
+func TestEnumerateE_CancelledAfterCompletion(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Only Enumerate is promoted, so this is treated as a plain Enumerable.
+	subject := struct{ Enumerable[int] }{AsEnumerable(1, 2, 3)}
+
+	results, err := EnumerateE[int](ctx, subject)
+	if got := results.ToSlice(); len(got) != 3 {
+		t.Logf("got: %v\nwant: %v", got, []int{1, 2, 3})
+		t.Fail()
+	}
+	cancel()
+
+	if got := err(); got != nil {
+		t.Logf("got: %v\nwant: %v", got, nil)
+		t.Fail()
+	}
+}
+
+func TestEnumerateE_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	subject := Where(FromSeq(func(yield func(int) bool) {
+		for i := 0; yield(i); i++ {
+			// Intentionally Left Blank
+		}
+	}), func(x int) bool {
+		return x%2 == 0
+	})
+
+	results, err := EnumerateE(ctx, subject)
+	<-results
+	cancel()
+	results.Discard()
+
+	if got := err(); !errors.Is(got, context.Canceled) {
+		t.Logf("got: %v\nwant: %v", got, context.Canceled)
+		t.Fail()
+	}
+}
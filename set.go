@@ -0,0 +1,226 @@
+package collection
+
+import (
+	"context"
+	"iter"
+)
+
+// set tracks which values have been seen by a set operation.
+type set[T any] interface {
+	// add records a value, and reports whether or not it had been seen before.
+	add(T) bool
+	contains(T) bool
+}
+
+type keyedSet[T any, K comparable] struct {
+	key  Transform[T, K]
+	seen map[K]struct{}
+}
+
+func newKeyedSet[T any, K comparable](key Transform[T, K]) func() set[T] {
+	return func() set[T] {
+		return keyedSet[T, K]{
+			key:  key,
+			seen: make(map[K]struct{}),
+		}
+	}
+}
+
+func (s keyedSet[T, K]) add(value T) bool {
+	k := s.key(value)
+	if _, ok := s.seen[k]; ok {
+		return false
+	}
+	s.seen[k] = struct{}{}
+	return true
+}
+
+func (s keyedSet[T, K]) contains(value T) bool {
+	_, ok := s.seen[s.key(value)]
+	return ok
+}
+
+type hashedSet[T any] struct {
+	equal   func(a, b T) bool
+	hash    func(T) uint64
+	buckets map[uint64][]T
+}
+
+func newHashedSet[T any](equal func(a, b T) bool, hash func(T) uint64) func() set[T] {
+	return func() set[T] {
+		return hashedSet[T]{
+			equal:   equal,
+			hash:    hash,
+			buckets: make(map[uint64][]T),
+		}
+	}
+}
+
+func (s hashedSet[T]) add(value T) bool {
+	h := s.hash(value)
+	for _, candidate := range s.buckets[h] {
+		if s.equal(candidate, value) {
+			return false
+		}
+	}
+	s.buckets[h] = append(s.buckets[h], value)
+	return true
+}
+
+func (s hashedSet[T]) contains(value T) bool {
+	for _, candidate := range s.buckets[s.hash(value)] {
+		if s.equal(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+type setOperation uint
+
+const (
+	setOperationDistinct setOperation = iota
+	setOperationUnion
+	setOperationIntersect
+	setOperationExcept
+)
+
+type setOperator[T any] struct {
+	left      Enumerable[T]
+	right     Enumerable[T]
+	operation setOperation
+	newSet    func() set[T]
+}
+
+// Distinct creates a reusable stream which omits any value that has already been encountered.
+func Distinct[T comparable](subject Enumerable[T]) Enumerable[T] {
+	return setOperator[T]{
+		left:      subject,
+		operation: setOperationDistinct,
+		newSet:    newKeyedSet(Identity[T]()),
+	}
+}
+
+// DistinctBy creates a reusable stream which omits any value whose key matches that of a value that has already been
+// encountered.
+func DistinctBy[T any, K comparable](subject Enumerable[T], key Transform[T, K]) Enumerable[T] {
+	return setOperator[T]{
+		left:      subject,
+		operation: setOperationDistinct,
+		newSet:    newKeyedSet(key),
+	}
+}
+
+// DistinctFunc creates a reusable stream which omits any value that is equal to one which has already been
+// encountered. It is useful for types which aren't comparable. Values which are equal must also have equal hashes.
+func DistinctFunc[T any](subject Enumerable[T], equal func(a, b T) bool, hash func(T) uint64) Enumerable[T] {
+	return setOperator[T]{
+		left:      subject,
+		operation: setOperationDistinct,
+		newSet:    newHashedSet(equal, hash),
+	}
+}
+
+// Except creates a reusable stream of the distinct values of `left` which are not present in `right`.
+//
+// Each enumeration reads all of `right` before streaming `left`.
+func Except[T comparable](left, right Enumerable[T]) Enumerable[T] {
+	return setOperator[T]{
+		left:      left,
+		right:     right,
+		operation: setOperationExcept,
+		newSet:    newKeyedSet(Identity[T]()),
+	}
+}
+
+// Intersect creates a reusable stream of the distinct values of `left` which are also present in `right`.
+//
+// Each enumeration reads all of `right` before streaming `left`.
+func Intersect[T comparable](left, right Enumerable[T]) Enumerable[T] {
+	return setOperator[T]{
+		left:      left,
+		right:     right,
+		operation: setOperationIntersect,
+		newSet:    newKeyedSet(Identity[T]()),
+	}
+}
+
+// Union creates a reusable stream of the distinct values present in either `left` or `right`. All values of `left`
+// are produced before those of `right`, and both are streamed.
+func Union[T comparable](left, right Enumerable[T]) Enumerable[T] {
+	return setOperator[T]{
+		left:      left,
+		right:     right,
+		operation: setOperationUnion,
+		newSet:    newKeyedSet(Identity[T]()),
+	}
+}
+
+func (s setOperator[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, s)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration of either side early.
+func (s setOperator[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, s)
+}
+
+func (s setOperator[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	left, leftErr := asSequence(ctx, s.left)
+	if s.operation == setOperationDistinct {
+		return func(yield func(T) bool) {
+			seen := s.newSet()
+			for entry := range left {
+				if seen.add(entry) && !yield(entry) {
+					return
+				}
+			}
+		}, leftErr
+	}
+
+	right, rightErr := asSequence(ctx, s.right)
+	errs := firstError(leftErr, rightErr)
+
+	if s.operation == setOperationUnion {
+		return func(yield func(T) bool) {
+			seen := s.newSet()
+			for entry := range left {
+				if seen.add(entry) && !yield(entry) {
+					return
+				}
+			}
+			if leftErr() != nil {
+				return
+			}
+			for entry := range right {
+				if seen.add(entry) && !yield(entry) {
+					return
+				}
+			}
+		}, errs
+	}
+
+	return func(yield func(T) bool) {
+		others := s.newSet()
+		for entry := range right {
+			others.add(entry)
+		}
+		if rightErr() != nil {
+			return
+		}
+
+		seen := s.newSet()
+		for entry := range left {
+			var include bool
+			if s.operation == setOperationIntersect {
+				include = others.contains(entry) && seen.add(entry)
+			} else {
+				include = others.add(entry)
+			}
+
+			if include && !yield(entry) {
+				return
+			}
+		}
+	}, errs
+}
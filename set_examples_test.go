@@ -0,0 +1,58 @@
+package collection_test
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleDistinct() {
+	subject := collection.AsEnumerable(1, 2, 1, 3, 2, 4)
+	fmt.Println(collection.ToSlice(collection.Distinct(subject)))
+	// Output: [1 2 3 4]
+}
+
+func ExampleDistinctBy() {
+	subject := collection.AsEnumerable("Go", "gopher", "GO", "rust", "Rust")
+	fmt.Println(collection.ToSlice(collection.DistinctBy(subject, strings.ToLower)))
+	// Output: [Go gopher rust]
+}
+
+func ExampleDistinctFunc() {
+	subject := collection.AsEnumerable([]string{"a", "b"}, []string{"c"}, []string{"a", "b"})
+
+	equal := func(a, b []string) bool {
+		return strings.Join(a, ",") == strings.Join(b, ",")
+	}
+	hash := func(x []string) uint64 {
+		h := fnv.New64()
+		h.Write([]byte(strings.Join(x, ",")))
+		return h.Sum64()
+	}
+
+	fmt.Println(collection.ToSlice(collection.DistinctFunc(subject, equal, hash)))
+	// Output: [[a b] [c]]
+}
+
+func ExampleUnion() {
+	a := collection.AsEnumerable(1, 2, 3, 2)
+	b := collection.AsEnumerable(5, 3, 4)
+	fmt.Println(collection.ToSlice(collection.Union(a, b)))
+	// Output: [1 2 3 5 4]
+}
+
+func ExampleIntersect() {
+	a := collection.AsEnumerable(1, 2, 3, 2, 4)
+	b := collection.AsEnumerable(4, 2, 6)
+	fmt.Println(collection.ToSlice(collection.Intersect(a, b)))
+	// Output: [2 4]
+}
+
+func ExampleExcept() {
+	a := collection.AsEnumerable(1, 2, 3, 2, 4, 1)
+	b := collection.AsEnumerable(4, 6)
+	fmt.Println(collection.ToSlice(collection.Except(a, b)))
+	// Output: [1 2 3]
+}
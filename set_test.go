@@ -0,0 +1,33 @@
+package collection
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDistinct_Lazy(t *testing.T) {
+	got := ToSlice(Take(Distinct(Select(Fibonacci, func(x uint) uint {
+		return x % 4
+	})), 3))
+
+	if want := "[0 1 2]"; fmt.Sprint(got) != want {
+		t.Logf("got: %v\nwant: %s", got, want)
+		t.Fail()
+	}
+}
+
+func TestUnion_LazyLeft(t *testing.T) {
+	got := ToSlice(Take(Union(Fibonacci, Fibonacci), 4))
+	if want := "[0 1 2 3]"; fmt.Sprint(got) != want {
+		t.Logf("got: %v\nwant: %s", got, want)
+		t.Fail()
+	}
+}
+
+func TestExcept_LazyLeft(t *testing.T) {
+	got := ToSlice(Take(Except(Fibonacci, AsEnumerable[uint](0, 1, 2)), 3))
+	if want := "[3 5 8]"; fmt.Sprint(got) != want {
+		t.Logf("got: %v\nwant: %s", got, want)
+		t.Fail()
+	}
+}
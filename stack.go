@@ -2,6 +2,7 @@ package collection
 
 import (
 	"context"
+	"iter"
 	"sync"
 )
 
@@ -22,6 +23,25 @@ func NewStack[T any](entries ...T) *Stack[T] {
 	return retval
 }
 
+// All returns an iterator over each position and value in the Stack, beginning with the top.
+//
+// The Stack is locked for reading while the iterator runs, so it must not be modified from within the loop body.
+func (stack *Stack[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		stack.key.RLock()
+		defer stack.key.RUnlock()
+
+		if stack.underlyer == nil {
+			return
+		}
+		for i, entry := range stack.underlyer.All() {
+			if !yield(i, entry) {
+				return
+			}
+		}
+	}
+}
+
 // Enumerate peeks at each element in the stack without mutating it.
 func (stack *Stack[T]) Enumerate(ctx context.Context) Enumerator[T] {
 	stack.key.RLock()
@@ -66,6 +86,19 @@ func (stack *Stack[T]) Peek() (T, bool) {
 	return stack.underlyer.PeekFront()
 }
 
+// Seq returns an iterator over each value in the Stack, beginning with the top.
+//
+// The Stack is locked for reading while the iterator runs, so it must not be modified from within the loop body.
+func (stack *Stack[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, entry := range stack.All() {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
 // Size returns the number of entries populating the Stack.
 func (stack *Stack[T]) Size() uint {
 	stack.key.RLock()
@@ -76,3 +76,15 @@ func TestStack_Pop_NonConstructorEmpty(t *testing.T) {
 		t.Logf("got: %v\nwant: %v", result, nil)
 	}
 }
+
+func TestStack_All(t *testing.T) {
+	subject := NewStack("alfa", "bravo", "charlie")
+	want := []string{"charlie", "bravo", "alfa"}
+
+	for i, got := range subject.All() {
+		if got != want[i] {
+			t.Logf("got: %s\nwant: %s", got, want[i])
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+)
+
+// A collection of errors that may be thrown by functions in this file.
+var (
+	ErrTeeOverflow = errors.New("tee branch fell too far behind, and its buffer overflowed")
+	ErrTeeConsumed = errors.New("tee branch has already been enumerated")
+)
+
+// TeePolicy determines what happens when a branch created by TeeN has a full buffer, and a new value arrives.
+type TeePolicy uint
+
+// The policies which may be applied to a slow branch created by TeeN.
+const (
+	// TeeBlock waits for the slow branch to make room, holding back every other branch in the meantime.
+	TeeBlock TeePolicy = iota
+
+	// TeeDrop skips the new value for the slow branch only. Other branches are not affected.
+	TeeDrop
+
+	// TeeError ends the slow branch, which reports ErrTeeOverflow. Other branches are not affected.
+	TeeError
+)
+
+// TeeN splits an Enumerable into `n` branches, each of which produces the same values. The source is enumerated once,
+// beginning as soon as the first branch is enumerated. Each branch may only be enumerated once; enumerating it again
+// produces no values, and reports ErrTeeConsumed.
+//
+// Every branch buffers up to `bufferSize` values which have not yet been consumed, including branches which have not
+// yet been enumerated. When a branch's buffer is full, `policy` determines whether the other branches wait for it, or it
+// misses values. A branch whose context is cancelled, or which stops consuming early, is detached so that it never
+// holds back the others. Once every branch is detached, enumeration of the source is cancelled.
+//
+// Cancelling `ctx` detaches every branch which has not yet been enumerated, so that a branch which is never going to
+// be enumerated can be abandoned without holding back the others. A branch detached this way reports ctx's error if it
+// is enumerated later. Branches which have already been enumerated are not affected.
+func TeeN[T any](ctx context.Context, subject Enumerable[T], n, bufferSize uint, policy TeePolicy) []EnumerableE[T] {
+	sourceCtx, cancel := context.WithCancel(context.Background())
+	source := &teeSource[T]{
+		subject:   subject,
+		policy:    policy,
+		abandoned: ctx,
+		ctx:       sourceCtx,
+		cancel:    cancel,
+		branches:  make([]*teeBranch[T], n),
+	}
+
+	retval := make([]EnumerableE[T], n)
+	for i := range source.branches {
+		source.branches[i] = &teeBranch[T]{
+			source:   source,
+			buffer:   make(chan T, bufferSize),
+			attached: make(chan struct{}),
+			done:     make(chan struct{}),
+		}
+		retval[i] = source.branches[i]
+	}
+	return retval
+}
+
+// TeeN splits this Enumerator into `n` Enumerators, each of which produces the same values. See the TeeN function for
+// how `bufferSize` and `policy` are applied. Every Enumerator returned must be consumed, unless policy is TeeDrop or
+// TeeError.
+func (iter Enumerator[T]) TeeN(n, bufferSize uint, policy TeePolicy) []Enumerator[T] {
+	source := FromSeq(func(yield func(T) bool) {
+		for entry := range iter {
+			if !yield(entry) {
+				return
+			}
+		}
+	})
+
+	branches := TeeN(context.Background(), source, n, bufferSize, policy)
+	retval := make([]Enumerator[T], len(branches))
+	for i, branch := range branches {
+		retval[i] = branch.Enumerate(context.Background())
+	}
+	return retval
+}
+
+type teeSource[T any] struct {
+	subject  Enumerable[T]
+	policy   TeePolicy
+	ctx      context.Context
+	cancel   context.CancelFunc
+	branches []*teeBranch[T]
+	started  sync.Once
+
+	// abandoned is done once branches which have not been enumerated should no longer be waited for.
+	abandoned context.Context
+}
+
+// attach records that a branch has begun its enumeration, and starts distributing values if it is the first to do so.
+func (s *teeSource[T]) attach(branch *teeBranch[T]) {
+	close(branch.attached)
+	s.started.Do(func() {
+		go s.distribute()
+	})
+}
+
+// distribute reads each value from the source, and hands it to every branch which is still attached, or which may yet
+// be.
+func (s *teeSource[T]) distribute() {
+	defer s.cancel()
+
+	live := append([]*teeBranch[T](nil), s.branches...)
+	detach := func(i int, err error) {
+		live[i].err = err
+		close(live[i].buffer)
+		live[i] = nil
+	}
+
+	source, sourceErr := asSequence(s.ctx, s.subject)
+	for entry := range source {
+		remaining := 0
+		for i, branch := range live {
+			if branch == nil {
+				continue
+			}
+
+			if err := branch.abandoned(s.abandoned); err != nil {
+				detach(i, err)
+				continue
+			}
+
+			select {
+			case <-branch.done:
+				detach(i, nil)
+				continue
+			default:
+				// Intentionally Left Blank
+			}
+
+			switch s.policy {
+			case TeeDrop:
+				select {
+				case branch.buffer <- entry:
+					// Intentionally Left Blank
+				default:
+					// Intentionally Left Blank
+				}
+			case TeeError:
+				select {
+				case branch.buffer <- entry:
+					// Intentionally Left Blank
+				default:
+					detach(i, ErrTeeOverflow)
+					continue
+				}
+			default:
+				if ok, err := s.block(branch, entry); !ok {
+					detach(i, err)
+					continue
+				}
+			}
+			remaining++
+		}
+
+		if remaining == 0 {
+			return
+		}
+	}
+
+	err := sourceErr()
+	for i, branch := range live {
+		if branch != nil {
+			detach(i, err)
+		}
+	}
+}
+
+// block waits until there is room in a branch's buffer for a value. It reports false if the branch stops consuming, or
+// is abandoned before it is enumerated, in which case it should be detached with the error returned.
+func (s *teeSource[T]) block(branch *teeBranch[T], entry T) (bool, error) {
+	abandoned := s.abandoned.Done()
+	for {
+		select {
+		case branch.buffer <- entry:
+			return true, nil
+		case <-branch.done:
+			return false, nil
+		case <-abandoned:
+			if err := branch.abandoned(s.abandoned); err != nil {
+				return false, err
+			}
+			// The branch was enumerated in time, so only its own progress matters from now on.
+			abandoned = nil
+		}
+	}
+}
+
+type teeBranch[T any] struct {
+	source  *teeSource[T]
+	buffer  chan T
+	claimed sync.Once
+
+	// attached is closed once the branch has begun its enumeration.
+	attached chan struct{}
+
+	// done is closed once the branch has stopped consuming, for any reason.
+	done chan struct{}
+	err  error
+}
+
+// abandoned reports ctx's error if the branch has not begun its enumeration, and ctx is done.
+func (b *teeBranch[T]) abandoned(ctx context.Context) error {
+	select {
+	case <-b.attached:
+		return nil
+	default:
+		return ctx.Err()
+	}
+}
+
+// Enumerate produces the values of the source, as they are distributed to this branch.
+func (b *teeBranch[T]) Enumerate(ctx context.Context) Enumerator[T] {
+	return enumerateSequence[T](ctx, b)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early, including the
+// source's errors, ErrTeeOverflow, and ErrTeeConsumed.
+func (b *teeBranch[T]) EnumerateE(ctx context.Context) (Enumerator[T], func() error) {
+	return enumerateSequenceE[T](ctx, b)
+}
+
+func (b *teeBranch[T]) sequence(ctx context.Context) (iter.Seq[T], func() error) {
+	var err error
+	return func(yield func(T) bool) {
+		claimed := false
+		b.claimed.Do(func() {
+			claimed = true
+			b.source.attach(b)
+		})
+		if !claimed {
+			err = ErrTeeConsumed
+			return
+		}
+		defer close(b.done)
+
+		for {
+			select {
+			case entry, ok := <-b.buffer:
+				if !ok {
+					err = b.err
+					return
+				}
+				if !yield(entry) {
+					return
+				}
+			case <-ctx.Done():
+				err = ctx.Err()
+				return
+			}
+		}
+	}, func() error { return err }
+}
@@ -0,0 +1,40 @@
+package collection_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleTeeN() {
+	branches := collection.TeeN(context.Background(), collection.Range(1, 4), 2, 4, collection.TeeBlock)
+
+	var wg sync.WaitGroup
+	var sum, product int
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		sum, _ = collection.Sum[int](branches[0])
+	}()
+	go func() {
+		defer wg.Done()
+		product, _ = collection.Aggregate[int](branches[1], 1, func(a, b int) int { return a * b })
+	}()
+	wg.Wait()
+
+	fmt.Println(sum, product)
+	// Output: 10 24
+}
+
+func ExampleEnumerator_TeeN() {
+	ctx := context.Background()
+	branches := collection.AsEnumerable("a", "b", "c").Enumerate(ctx).TeeN(2, 3, collection.TeeBlock)
+
+	fmt.Println(branches[0].ToSlice())
+	fmt.Println(branches[1].ToSlice())
+	// Output:
+	// [a b c]
+	// [a b c]
+}
@@ -0,0 +1,235 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTeeN_Block(t *testing.T) {
+	branches := TeeN(context.Background(), Range(0, 100), 3, 2, TeeBlock)
+
+	var wg sync.WaitGroup
+	sums := make([]int, len(branches))
+	for i, branch := range branches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for entry := range branch.Enumerate(context.Background()) {
+				if i == 0 {
+					time.Sleep(time.Microsecond)
+				}
+				sums[i] += entry
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, got := range sums {
+		if got != 4950 {
+			t.Logf("branch %d\ngot: %d\nwant: %d", i, got, 4950)
+			t.Fail()
+		}
+	}
+}
+
+func TestTeeN_DetachCancelled(t *testing.T) {
+	branches := TeeN[uint](context.Background(), Fibonacci, 2, 0, TeeBlock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	abandoned, abandonedErr := branches[0].EnumerateE(ctx)
+	cancel()
+
+	got := ToSlice(Take[uint](branches[1], 50))
+	if len(got) != 50 {
+		t.Logf("got: %d values\nwant: %d values", len(got), 50)
+		t.Fail()
+	}
+
+	for range abandoned {
+		// Intentionally Left Blank
+	}
+	if err := abandonedErr(); !errors.Is(err, context.Canceled) {
+		t.Logf("got: %v\nwant: %v", err, context.Canceled)
+		t.Fail()
+	}
+}
+
+func TestTeeN_Consumed(t *testing.T) {
+	branches := TeeN(context.Background(), AsEnumerable(1, 2, 3), 1, 3, TeeBlock)
+
+	if got, err := ToSliceE(branches[0]); err != nil || len(got) != 3 {
+		t.Logf("got: %v %v\nwant: %v", got, err, []int{1, 2, 3})
+		t.Fail()
+	}
+
+	got, err := ToSliceE(branches[0])
+	if err != ErrTeeConsumed {
+		t.Logf("got: %v\nwant: %v", err, ErrTeeConsumed)
+		t.Fail()
+	}
+	if len(got) != 0 {
+		t.Logf("got: %v\nwant: no values", got)
+		t.Fail()
+	}
+}
+
+func TestTeeN_StartsOnFirstEnumeration(t *testing.T) {
+	branches := TeeN(context.Background(), Range(0, 3), 2, 3, TeeBlock)
+
+	for i, branch := range branches {
+		got, err := ToSliceE(branch)
+		if err != nil || len(got) != 3 {
+			t.Logf("branch %d\ngot: %v %v\nwant: %v", i, got, err, []int{0, 1, 2})
+			t.Fail()
+		}
+	}
+}
+
+func TestTeeN_Abandoned(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	branches := TeeN[uint](ctx, Fibonacci, 2, 1, TeeBlock)
+	cancel()
+
+	got := ToSlice(Take[uint](branches[1], 50))
+	if len(got) != 50 {
+		t.Logf("got: %d values\nwant: %d values", len(got), 50)
+		t.Fail()
+	}
+
+	if _, err := ToSliceE(branches[0]); !errors.Is(err, context.Canceled) {
+		t.Logf("got: %v\nwant: %v", err, context.Canceled)
+		t.Fail()
+	}
+}
+
+func TestTeeN_AbandonedWhileBlocked(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	branches := TeeN[uint](ctx, Fibonacci, 2, 1, TeeBlock)
+
+	liveCtx, stop := context.WithCancel(context.Background())
+	defer stop()
+	live := branches[1].Enumerate(liveCtx)
+	for i := 0; i < 2; i++ {
+		// The abandoned branch can only buffer one value, so the second is held back until it is detached.
+		if i == 1 {
+			cancel()
+		}
+		if _, ok := <-live; !ok {
+			t.Log("live branch ended early")
+			t.FailNow()
+		}
+	}
+}
+
+// pacedTee creates branches over a source that only produces a new value once the previous one has been received by
+// the first branch. The values received by the first branch are relayed on the returned channel, and the other branches
+// are left untouched.
+func pacedTee(t *testing.T, count int, policy TeePolicy) (Enumerator[int], func() error, []EnumerableE[int]) {
+	source := make(chan int)
+	branches := TeeN(context.Background(), FromSeq(func(yield func(int) bool) {
+		for entry := range source {
+			if !yield(entry) {
+				return
+			}
+		}
+	}), 2, 1, policy)
+
+	fast, fastErr := branches[0].EnumerateE(context.Background())
+	paced := make(chan int)
+	go func() {
+		defer close(paced)
+		for i := 0; i < count; i++ {
+			source <- i
+			if got := <-fast; got != i {
+				t.Logf("got: %d\nwant: %d", got, i)
+				t.Fail()
+			}
+			paced <- i
+		}
+
+		close(source)
+		for range fast {
+			// Intentionally Left Blank
+		}
+	}()
+
+	return paced, fastErr, branches[1:]
+}
+
+func TestTeeN_Drop(t *testing.T) {
+	paced, _, rest := pacedTee(t, 10, TeeDrop)
+	slow, _ := rest[0].EnumerateE(context.Background())
+
+	if fast := paced.ToSlice(); len(fast) != 10 {
+		t.Logf("got: %d values\nwant: %d values", len(fast), 10)
+		t.Fail()
+	}
+
+	got := slow.ToSlice()
+	if len(got) == 0 || len(got) >= 10 {
+		t.Logf("got: %d values\nwant: some, but not all, values", len(got))
+		t.Fail()
+	}
+}
+
+func TestTeeN_Error(t *testing.T) {
+	paced, fastErr, rest := pacedTee(t, 10, TeeError)
+	slow, slowErr := rest[0].EnumerateE(context.Background())
+
+	if fast := paced.ToSlice(); len(fast) != 10 {
+		t.Logf("got: %d values\nwant: %d values", len(fast), 10)
+		t.Fail()
+	}
+
+	slow.ToSlice()
+	if err := slowErr(); err != ErrTeeOverflow {
+		t.Logf("got: %v\nwant: %v", err, ErrTeeOverflow)
+		t.Fail()
+	}
+	if err := fastErr(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTeeN_SourceError(t *testing.T) {
+	errBroken := errors.New("broken")
+	broken := FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) {
+			yield(0, errBroken)
+		}
+	})
+
+	branches := TeeN[int](context.Background(), broken, 2, 1, TeeBlock)
+	results := make([]error, len(branches))
+	var wg sync.WaitGroup
+	for i, branch := range branches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, results[i] = ToSliceE(branch)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range results {
+		if !errors.Is(err, errBroken) {
+			t.Logf("branch %d\ngot: %v\nwant: %v", i, err, errBroken)
+			t.Fail()
+		}
+	}
+}
+
+func TestEnumerator_TeeN(t *testing.T) {
+	branches := AsEnumerable(1, 2, 3).Enumerate(context.Background()).TeeN(3, 3, TeeBlock)
+
+	for i, branch := range branches {
+		if got := branch.ToSlice(); len(got) != 3 {
+			t.Logf("branch %d\ngot: %v\nwant: %v", i, got, []int{1, 2, 3})
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package collection
+
+import (
+	"context"
+	"iter"
+)
+
+// TraverseOptions configures how the Traverse family of functions walk a hierarchy. The zero value visits every node
+// which can be reached, with no protection against cycles.
+type TraverseOptions[T any, K comparable] struct {
+	// Key identifies each node. When set, a node is only visited the first time its key is encountered, which prevents
+	// cycles from being followed forever. Nodes which can be reached by more than one path are also only visited once.
+	Key Transform[T, K]
+
+	// MaxDepth is the depth of the deepest nodes which will be visited, where the root has a depth of zero. When zero,
+	// there is no limit.
+	MaxDepth uint
+}
+
+// Visit is a node of a hierarchy, along with its distance from the root.
+type Visit[T any] struct {
+	Depth uint
+	Value T
+}
+
+type traverser[T any, K comparable] struct {
+	root         T
+	children     Unfolder[T, T]
+	options      TraverseOptions[T, K]
+	breadthFirst bool
+}
+
+// TraverseBreadthFirst creates a reusable stream of every node of a hierarchy, visiting each level completely before
+// moving on to the next. The children of a node are found by calling `children` when the node is visited, and its
+// Enumerator is always read completely.
+func TraverseBreadthFirst[T any, K comparable](root T, children Unfolder[T, T], options TraverseOptions[T, K]) Enumerable[T] {
+	return withoutDepth(TraverseBreadthFirstWithDepth(root, children, options))
+}
+
+// TraverseBreadthFirstWithDepth behaves like TraverseBreadthFirst, but also reports the depth of each node.
+func TraverseBreadthFirstWithDepth[T any, K comparable](root T, children Unfolder[T, T], options TraverseOptions[T, K]) Enumerable[Visit[T]] {
+	return traverser[T, K]{
+		root:         root,
+		children:     children,
+		options:      options,
+		breadthFirst: true,
+	}
+}
+
+// TraverseDepthFirst creates a reusable stream of every node of a hierarchy, visiting each node before its children,
+// and all of a node's descendants before its next sibling. The children of a node are found by calling `children` when
+// the node is visited, and its Enumerator is always read completely.
+func TraverseDepthFirst[T any, K comparable](root T, children Unfolder[T, T], options TraverseOptions[T, K]) Enumerable[T] {
+	return withoutDepth(TraverseDepthFirstWithDepth(root, children, options))
+}
+
+// TraverseDepthFirstWithDepth behaves like TraverseDepthFirst, but also reports the depth of each node.
+func TraverseDepthFirstWithDepth[T any, K comparable](root T, children Unfolder[T, T], options TraverseOptions[T, K]) Enumerable[Visit[T]] {
+	return traverser[T, K]{
+		root:     root,
+		children: children,
+		options:  options,
+	}
+}
+
+func withoutDepth[T any](visits Enumerable[Visit[T]]) Enumerable[T] {
+	return Select(visits, func(visit Visit[T]) T {
+		return visit.Value
+	})
+}
+
+func (t traverser[T, K]) Enumerate(ctx context.Context) Enumerator[Visit[T]] {
+	return enumerateSequence[Visit[T]](ctx, t)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration early. The only error that
+// will be reported is the cancellation of ctx.
+func (t traverser[T, K]) EnumerateE(ctx context.Context) (Enumerator[Visit[T]], func() error) {
+	return enumerateSequenceE[Visit[T]](ctx, t)
+}
+
+func (t traverser[T, K]) sequence(ctx context.Context) (iter.Seq[Visit[T]], func() error) {
+	var err error
+	return func(yield func(Visit[T]) bool) {
+		var seen map[K]struct{}
+		if t.options.Key != nil {
+			seen = make(map[K]struct{})
+		}
+
+		// pending holds the nodes which have been discovered, but not yet visited. Breadth-first traversals take from
+		// the front, and depth-first traversals from the back.
+		pending := []Visit[T]{{Value: t.root}}
+		for len(pending) > 0 {
+			if err = ctx.Err(); err != nil {
+				return
+			}
+
+			var current Visit[T]
+			if t.breadthFirst {
+				current, pending = pending[0], pending[1:]
+			} else {
+				last := len(pending) - 1
+				current, pending = pending[last], pending[:last]
+			}
+
+			if seen != nil {
+				key := t.options.Key(current.Value)
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+			}
+
+			if !yield(current) {
+				return
+			}
+
+			if t.options.MaxDepth > 0 && current.Depth >= t.options.MaxDepth {
+				continue
+			}
+
+			discovered := len(pending)
+			for child := range t.children(current.Value) {
+				pending = append(pending, Visit[T]{Depth: current.Depth + 1, Value: child})
+			}
+
+			// Children are pushed in the order they were produced, so they must be flipped for the first of them to be
+			// visited first by a depth-first traversal.
+			if !t.breadthFirst {
+				for left, right := discovered, len(pending)-1; left < right; left, right = left+1, right-1 {
+					pending[left], pending[right] = pending[right], pending[left]
+				}
+			}
+		}
+	}, func() error { return err }
+}
@@ -0,0 +1,58 @@
+package collection_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleTraverseDepthFirst() {
+	// Walk a directory tree without using Directory, listing each entry's children with os.ReadDir.
+	children := func(path string) collection.Enumerator[string] {
+		entries, _ := os.ReadDir(path)
+		results := make([]string, len(entries))
+		for i, entry := range entries {
+			results[i] = filepath.Join(path, entry.Name())
+		}
+		return collection.AsEnumerable(results...).Enumerate(context.Background())
+	}
+
+	walked := collection.TraverseDepthFirst(filepath.Join("testdata", "foo"), children, collection.TraverseOptions[string, string]{})
+	for path := range walked.Enumerate(context.Background()) {
+		fmt.Println(filepath.ToSlash(path))
+	}
+	// Output:
+	// testdata/foo
+	// testdata/foo/a.txt
+	// testdata/foo/bar
+	// testdata/foo/bar/b.txt
+	// testdata/foo/c.txt
+}
+
+func ExampleTraverseBreadthFirstWithDepth() {
+	org := map[string][]string{
+		"CEO": {"CTO", "CFO"},
+		"CTO": {"Engineer"},
+		"CFO": {"Accountant"},
+	}
+	reports := func(role string) collection.Enumerator[string] {
+		return collection.AsEnumerable(org[role]...).Enumerate(context.Background())
+	}
+
+	chart := collection.TraverseBreadthFirstWithDepth("CEO", reports, collection.TraverseOptions[string, string]{
+		Key: collection.Identity[string](),
+	})
+	for visit := range chart.Enumerate(context.Background()) {
+		fmt.Println(strings.Repeat("  ", int(visit.Depth)) + visit.Value)
+	}
+	// Output:
+	// CEO
+	//   CTO
+	//   CFO
+	//     Engineer
+	//     Accountant
+}
@@ -0,0 +1,98 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// graphChildren creates an Unfolder over a graph described by an adjacency map.
+func graphChildren(edges map[string][]string) Unfolder[string, string] {
+	return func(node string) Enumerator[string] {
+		return AsEnumerable(edges[node]...).Enumerate(context.Background())
+	}
+}
+
+func TestTraverse(t *testing.T) {
+	tree := graphChildren(map[string][]string{
+		"root": {"a", "b"},
+		"a":    {"a1", "a2"},
+		"b":    {"b1"},
+		"a1":   {"a1x"},
+	})
+
+	testCases := []struct {
+		name    string
+		subject Enumerable[string]
+		want    string
+	}{
+		{"depth first", TraverseDepthFirst("root", tree, TraverseOptions[string, string]{}), "[root a a1 a1x a2 b b1]"},
+		{"breadth first", TraverseBreadthFirst("root", tree, TraverseOptions[string, string]{}), "[root a b a1 a2 b1 a1x]"},
+		{"depth first, max depth", TraverseDepthFirst("root", tree, TraverseOptions[string, string]{MaxDepth: 1}), "[root a b]"},
+		{"breadth first, max depth", TraverseBreadthFirst("root", tree, TraverseOptions[string, string]{MaxDepth: 2}), "[root a b a1 a2 b1]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fmt.Sprint(ToSlice(tc.subject)); got != tc.want {
+				t.Logf("got: %s\nwant: %s", got, tc.want)
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestTraverse_Cycle(t *testing.T) {
+	graph := graphChildren(map[string][]string{
+		"a": {"b", "c"},
+		"b": {"a", "c"},
+		"c": {"a"},
+	})
+	options := TraverseOptions[string, string]{Key: Identity[string]()}
+
+	if got, want := fmt.Sprint(ToSlice(TraverseDepthFirst("a", graph, options))), "[a b c]"; got != want {
+		t.Logf("got: %s\nwant: %s", got, want)
+		t.Fail()
+	}
+	if got, want := fmt.Sprint(ToSlice(TraverseBreadthFirst("a", graph, options))), "[a b c]"; got != want {
+		t.Logf("got: %s\nwant: %s", got, want)
+		t.Fail()
+	}
+}
+
+func TestTraverseDepthFirstWithDepth(t *testing.T) {
+	tree := graphChildren(map[string][]string{
+		"root": {"a", "b"},
+		"a":    {"a1"},
+	})
+
+	got := fmt.Sprint(ToSlice(TraverseDepthFirstWithDepth("root", tree, TraverseOptions[string, string]{})))
+	want := "[{0 root} {1 a} {2 a1} {1 b}]"
+	if got != want {
+		t.Logf("got: %s\nwant: %s", got, want)
+		t.Fail()
+	}
+}
+
+func TestTraverse_Cancelled(t *testing.T) {
+	// Every number has two children, so this hierarchy never ends.
+	infinite := func(x uint) Enumerator[uint] {
+		return AsEnumerable(2*x+1, 2*x+2).Enumerate(context.Background())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	results, err := EnumerateE(ctx, TraverseBreadthFirst(0, infinite, TraverseOptions[uint, uint]{}))
+	for entry := range results {
+		if entry == 100 {
+			cancel()
+		}
+	}
+
+	if !errors.Is(err(), context.Canceled) {
+		t.Logf("got: %v\nwant: %v", err(), context.Canceled)
+		t.Fail()
+	}
+}
@@ -0,0 +1,195 @@
+package collection
+
+import (
+	"context"
+	"iter"
+)
+
+// Pair holds two related values.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+type zipper[A any, B any, R any] struct {
+	a       Enumerable[A]
+	b       Enumerable[B]
+	combine func(A, B) R
+	longest bool
+	fillA   A
+	fillB   B
+}
+
+// Zip creates a reusable stream which combines the values of two Enumerables element-wise. It ends as soon as either
+// of them does, at which point enumeration of the other is cancelled.
+func Zip[A any, B any, R any](a Enumerable[A], b Enumerable[B], combine func(A, B) R) Enumerable[R] {
+	return zipper[A, B, R]{
+		a:       a,
+		b:       b,
+		combine: combine,
+	}
+}
+
+// ZipLongest creates a reusable stream which combines the values of two Enumerables element-wise. It continues until
+// both of them have ended, substituting `fillA` or `fillB` for the values of whichever ended first.
+func ZipLongest[A any, B any, R any](a Enumerable[A], b Enumerable[B], fillA A, fillB B, combine func(A, B) R) Enumerable[R] {
+	return zipper[A, B, R]{
+		a:       a,
+		b:       b,
+		combine: combine,
+		longest: true,
+		fillA:   fillA,
+		fillB:   fillB,
+	}
+}
+
+// ZipPairs creates a reusable stream which pairs up the values of two Enumerables element-wise. It ends as soon as
+// either of them does, at which point enumeration of the other is cancelled.
+func ZipPairs[A any, B any](a Enumerable[A], b Enumerable[B]) Enumerable[Pair[A, B]] {
+	return Zip(a, b, func(first A, second B) Pair[A, B] {
+		return Pair[A, B]{
+			First:  first,
+			Second: second,
+		}
+	})
+}
+
+func (z zipper[A, B, R]) Enumerate(ctx context.Context) Enumerator[R] {
+	return enumerateSequence[R](ctx, z)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration of either source early.
+func (z zipper[A, B, R]) EnumerateE(ctx context.Context) (Enumerator[R], func() error) {
+	return enumerateSequenceE[R](ctx, z)
+}
+
+func (z zipper[A, B, R]) sequence(ctx context.Context) (iter.Seq[R], func() error) {
+	a, aErr := asSequence(ctx, z.a)
+	b, bErr := asSequence(ctx, z.b)
+	errs := firstError(aErr, bErr)
+
+	return func(yield func(R) bool) {
+		nextA, stopA := iter.Pull(a)
+		defer stopA()
+		nextB, stopB := iter.Pull(b)
+		defer stopB()
+
+		aOpen, bOpen := true, true
+		for {
+			var currentA A
+			var currentB B
+			if aOpen {
+				currentA, aOpen = nextA()
+			}
+			if bOpen {
+				currentB, bOpen = nextB()
+			}
+
+			if errs() != nil {
+				return
+			}
+
+			if z.longest {
+				if !aOpen && !bOpen {
+					return
+				}
+				if !aOpen {
+					currentA = z.fillA
+				}
+				if !bOpen {
+					currentB = z.fillB
+				}
+			} else if !aOpen || !bOpen {
+				return
+			}
+
+			if !yield(z.combine(currentA, currentB)) {
+				return
+			}
+		}
+	}, errs
+}
+
+type zipperN[T any] struct {
+	sources []Enumerable[T]
+	longest bool
+	fill    T
+}
+
+// ZipN creates a reusable stream which gathers the values of several Enumerables element-wise. Each value produced is a
+// new slice, holding one value from each source in the order the sources were provided. It ends as soon as any source
+// does, at which point enumeration of the others is cancelled.
+func ZipN[T any](sources []Enumerable[T]) Enumerable[[]T] {
+	return zipperN[T]{
+		sources: sources,
+	}
+}
+
+// ZipNLongest creates a reusable stream which gathers the values of several Enumerables element-wise. It continues
+// until every source has ended, substituting `fill` for the values of sources which ended early.
+func ZipNLongest[T any](sources []Enumerable[T], fill T) Enumerable[[]T] {
+	return zipperN[T]{
+		sources: sources,
+		longest: true,
+		fill:    fill,
+	}
+}
+
+func (z zipperN[T]) Enumerate(ctx context.Context) Enumerator[[]T] {
+	return enumerateSequence[[]T](ctx, z)
+}
+
+// EnumerateE behaves like Enumerate, but also reports any error which ended the enumeration of any source early.
+func (z zipperN[T]) EnumerateE(ctx context.Context) (Enumerator[[]T], func() error) {
+	return enumerateSequenceE[[]T](ctx, z)
+}
+
+func (z zipperN[T]) sequence(ctx context.Context) (iter.Seq[[]T], func() error) {
+	sources := make([]iter.Seq[T], len(z.sources))
+	sourceErrs := make([]func() error, len(z.sources))
+	for i, source := range z.sources {
+		sources[i], sourceErrs[i] = asSequence(ctx, source)
+	}
+	errs := firstError(sourceErrs...)
+
+	return func(yield func([]T) bool) {
+		if len(sources) == 0 {
+			return
+		}
+
+		nexts := make([]func() (T, bool), len(sources))
+		open := make([]bool, len(sources))
+		for i, source := range sources {
+			var stop func()
+			nexts[i], stop = iter.Pull(source)
+			defer stop()
+			open[i] = true
+		}
+
+		for {
+			current := make([]T, len(sources))
+			remaining := 0
+			for i, next := range nexts {
+				if open[i] {
+					current[i], open[i] = next()
+				}
+
+				if open[i] {
+					remaining++
+				} else if z.longest {
+					current[i] = z.fill
+				} else {
+					return
+				}
+			}
+
+			if remaining == 0 || errs() != nil {
+				return
+			}
+
+			if !yield(current) {
+				return
+			}
+		}
+	}, errs
+}
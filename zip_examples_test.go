@@ -0,0 +1,60 @@
+package collection_test
+
+import (
+	"fmt"
+
+	"github.com/marstr/collection/v2"
+)
+
+func ExampleZip() {
+	labels := collection.NewList("zeroth", "first", "second", "third")
+	labelled := collection.Zip[uint, string](collection.Fibonacci, labels, func(value uint, label string) string {
+		return fmt.Sprintf("%s: %d", label, value)
+	})
+
+	for _, entry := range collection.ToSlice(labelled) {
+		fmt.Println(entry)
+	}
+	// Output:
+	// zeroth: 0
+	// first: 1
+	// second: 1
+	// third: 2
+}
+
+func ExampleZipLongest() {
+	a := collection.AsEnumerable(1, 2, 3)
+	b := collection.AsEnumerable("a")
+	zipped := collection.ZipLongest(a, b, -1, "?", func(x int, y string) string {
+		return fmt.Sprint(x, y)
+	})
+	fmt.Println(collection.ToSlice(zipped))
+	// Output: [1a 2? 3?]
+}
+
+func ExampleZipPairs() {
+	a := collection.AsEnumerable("x", "y", "z")
+	b := collection.AsEnumerable(true, false)
+	fmt.Println(collection.ToSlice(collection.ZipPairs(a, b)))
+	// Output: [{x true} {y false}]
+}
+
+func ExampleZipN() {
+	zipped := collection.ZipN([]collection.Enumerable[int]{
+		collection.AsEnumerable(1, 2, 3),
+		collection.AsEnumerable(4, 5, 6, 7),
+		collection.AsEnumerable(8, 9, 10),
+	})
+	fmt.Println(collection.ToSlice(zipped))
+	// Output: [[1 4 8] [2 5 9] [3 6 10]]
+}
+
+func ExampleZipNLongest() {
+	zipped := collection.ZipNLongest([]collection.Enumerable[int]{
+		collection.AsEnumerable(1, 2),
+		collection.AsEnumerable(3),
+		collection.AsEnumerable(4, 5, 6),
+	}, 0)
+	fmt.Println(collection.ToSlice(zipped))
+	// Output: [[1 3 4] [2 0 5] [0 0 6]]
+}
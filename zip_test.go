@@ -0,0 +1,54 @@
+package collection
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestZip_StopsSurvivors(t *testing.T) {
+	stopped := make(chan struct{})
+	infinite := FromSeq(func(yield func(int) bool) {
+		defer close(stopped)
+		for i := 0; yield(i); i++ {
+			// Intentionally Left Blank
+		}
+	})
+
+	got := ToSlice(Zip(AsEnumerable("a", "b"), infinite, func(a string, b int) int {
+		return b
+	}))
+	if len(got) != 2 {
+		t.Logf("got: %v\nwant: %v", got, []int{0, 1})
+		t.Fail()
+	}
+	<-stopped
+
+	stopped = make(chan struct{})
+	gotN := ToSlice(ZipN([]Enumerable[int]{infinite, AsEnumerable(7)}))
+	if len(gotN) != 1 {
+		t.Logf("got: %v\nwant: %v", gotN, [][]int{{0, 7}})
+		t.Fail()
+	}
+	<-stopped
+}
+
+func TestZip_Error(t *testing.T) {
+	errBroken := errors.New("broken")
+	broken := FromSeqE(func(yield func(int, error) bool) {
+		if yield(1, nil) {
+			yield(0, errBroken)
+		}
+	})
+
+	got, err := ToSliceE(ZipLongest(broken, Take(Fibonacci, 5), 0, 0, func(a int, b uint) int {
+		return a
+	}))
+	if !errors.Is(err, errBroken) {
+		t.Logf("got: %v\nwant: %v", err, errBroken)
+		t.Fail()
+	}
+	if len(got) != 1 {
+		t.Logf("got: %v\nwant: %v", got, []int{1})
+		t.Fail()
+	}
+}